@@ -1,6 +1,8 @@
 package main
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"os"
 
@@ -9,6 +11,7 @@ import (
 	"github.com/yourusername/dnd-character-creator/internal/handler"
 	"github.com/yourusername/dnd-character-creator/internal/logger"
 	"github.com/yourusername/dnd-character-creator/internal/middleware"
+	"github.com/yourusername/dnd-character-creator/internal/reference"
 	"github.com/yourusername/dnd-character-creator/internal/repository/mongo"
 	"github.com/yourusername/dnd-character-creator/internal/service"
 )
@@ -26,6 +29,24 @@ func main() {
 	logger.Init(cfg.Logging.Level, cfg.Logging.Format)
 	log := logger.GetLogger()
 
+	// Rolled ability score seeds are signed so clients can't pick their own
+	if cfg.Rules.RollSecret == "" {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			log.WithError(err).Fatal("Failed to generate roll secret")
+			os.Exit(1)
+		}
+		cfg.Rules.RollSecret = hex.EncodeToString(secret)
+		log.Warn("ROLL_SECRET is not set; rolled ability scores issued before a restart will not verify")
+	}
+
+	// Load SRD reference catalog
+	catalog, err := reference.Load()
+	if err != nil {
+		log.WithError(err).Fatal("Failed to load reference catalog")
+		os.Exit(1)
+	}
+
 	// Connect to MongoDB
 	client, err := mongo.Connect(cfg.Database.URI, cfg.Database.Timeout)
 	if err != nil {
@@ -46,13 +67,23 @@ func main() {
 
 	// Initialize repositories
 	characterRepo := mongo.NewCharacterRepository(client, cfg.Database.Database)
+	itemRepo := mongo.NewItemRepository(client, cfg.Database.Database)
 
 	// Initialize services
-	characterService := service.NewCharacterService(characterRepo)
+	characterService := service.NewCharacterService(characterRepo, itemRepo, catalog, cfg.Rules)
+	itemService := service.NewItemService(itemRepo)
+	referenceService := service.NewReferenceService(catalog)
+	abilityScoreService := service.NewAbilityScoreService(cfg.Rules.RollSecret)
+	diceService := service.NewDiceService()
 
 	// Initialize handlers
 	healthHandler := handler.NewHealthHandler()
 	characterHandler := handler.NewCharacterHandler(characterService)
+	itemHandler := handler.NewItemHandler(itemService)
+	transferHandler := handler.NewTransferHandler(characterService)
+	referenceHandler := handler.NewReferenceHandler(referenceService)
+	abilityScoreHandler := handler.NewAbilityScoreHandler(abilityScoreService)
+	diceHandler := handler.NewDiceHandler(diceService)
 
 	// Set Gin mode
 	gin.SetMode(cfg.Server.GinMode)
@@ -83,7 +114,57 @@ func main() {
 			characters.POST("", characterHandler.Create)
 			characters.PUT("/:id", characterHandler.Update)
 			characters.DELETE("/:id", characterHandler.Delete)
+			characters.GET("/:id/level-up", characterHandler.LevelUpOptions)
+			characters.POST("/:id/level-up", characterHandler.LevelUp)
+			characters.POST("/:id/experience", characterHandler.AwardExperience)
+			characters.POST("/:id/currency/transactions", characterHandler.Transact)
+			characters.POST("/:id/hp", characterHandler.ChangeHitPoints)
+			characters.POST("/:id/death-save", characterHandler.DeathSave)
+			characters.POST("/:id/cast", characterHandler.Cast)
+			characters.POST("/:id/attacks/:index/roll", characterHandler.RollAttack)
+			characters.POST("/:id/conditions", characterHandler.AddCondition)
+			characters.DELETE("/:id/conditions/:name", characterHandler.RemoveCondition)
+			characters.POST("/:id/resources/:name/spend", characterHandler.SpendResource)
+			characters.POST("/:id/resources/:name/restore", characterHandler.RestoreResource)
+			characters.POST("/:id/inventory/:itemId/equip", characterHandler.EquipItem)
+			characters.POST("/:id/inventory/:itemId/unequip", characterHandler.UnequipItem)
+			characters.POST("/:id/inventory/:itemId/move", characterHandler.MoveItem)
+			characters.POST("/:id/inventory/:itemId/charges/spend", characterHandler.SpendCharges)
+			characters.POST("/:id/rest/short", characterHandler.ShortRest)
+			characters.POST("/:id/rest/long", characterHandler.LongRest)
+			characters.POST("/:id/dawn", characterHandler.Dawn)
+		}
+
+		// Transfers between characters
+		v1.POST("/transfers", transferHandler.Create)
+
+		// Homebrew item routes
+		items := v1.Group("/items")
+		{
+			items.GET("", itemHandler.GetAll)
+			items.GET("/:id", itemHandler.GetByID)
+			items.POST("", itemHandler.Create)
+			items.PUT("/:id", itemHandler.Update)
+			items.DELETE("/:id", itemHandler.Delete)
+		}
+
+		// Reference data routes
+		references := v1.Group("/reference")
+		{
+			references.GET("", referenceHandler.Kinds)
+			references.GET("/:kind", referenceHandler.List)
+			references.GET("/:kind/:id", referenceHandler.GetByID)
 		}
+
+		// Ability score generation routes
+		abilityScores := v1.Group("/ability-scores")
+		{
+			abilityScores.POST("/generate", abilityScoreHandler.Generate)
+			abilityScores.POST("/validate", abilityScoreHandler.Validate)
+		}
+
+		// Dice rolling
+		v1.POST("/roll", diceHandler.Roll)
 	}
 
 	// Start server
@@ -13,6 +13,7 @@ type Config struct {
 	CORS     CORSConfig
 	Logging  LoggingConfig
 	App      AppConfig
+	Rules    RulesConfig
 }
 
 type ServerConfig struct {
@@ -41,6 +42,16 @@ type AppConfig struct {
 	MaxStringLength int
 }
 
+type RulesConfig struct {
+	ValidationMode     string
+	VariantEncumbrance bool
+	AdvancementMode    string
+	RollSecret         string
+	// AbilityScoreMethods lists the ability score methods strict validation
+	// accepts; empty accepts every method
+	AbilityScoreMethods []string
+}
+
 // Load loads configuration from environment variables
 func Load() *Config {
 	return &Config{
@@ -65,6 +76,13 @@ func Load() *Config {
 		App: AppConfig{
 			MaxStringLength: getEnvAsInt("MAX_STRING_LENGTH", 500),
 		},
+		Rules: RulesConfig{
+			ValidationMode:      getEnv("VALIDATION_MODE", "strict"),
+			VariantEncumbrance:  getEnvAsBool("VARIANT_ENCUMBRANCE", false),
+			AdvancementMode:     getEnv("ADVANCEMENT_MODE", "xp"),
+			RollSecret:          getEnv("ROLL_SECRET", ""),
+			AbilityScoreMethods: getEnvAsSlice("ABILITY_SCORE_METHODS", nil),
+		},
 	}
 }
 
@@ -83,6 +101,14 @@ func getEnvAsInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getEnvAsBool(key string, defaultValue bool) bool {
+	valueStr := getEnv(key, "")
+	if value, err := strconv.ParseBool(valueStr); err == nil {
+		return value
+	}
+	return defaultValue
+}
+
 func getEnvAsSlice(key string, defaultValue []string) []string {
 	valueStr := getEnv(key, "")
 	if valueStr == "" {
@@ -0,0 +1,323 @@
+package dice
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/yourusername/dnd-character-creator/internal/models"
+)
+
+// Roll modes for a d20 roll
+const (
+	Advantage    = "advantage"
+	Disadvantage = "disadvantage"
+)
+
+// Limits that keep a single roll cheap to evaluate
+const (
+	MaxTerms      = 20
+	MaxDice       = 100
+	MaxSides      = 1000
+	MaxExplosions = 100
+)
+
+// Ways to keep or drop dice from a group
+const (
+	KeepHighest = "kh"
+	KeepLowest  = "kl"
+	DropHighest = "dh"
+	DropLowest  = "dl"
+)
+
+// Expression is parsed dice notation: a sum of dice groups and constants
+type Expression struct {
+	Terms []Term
+}
+
+// Term is a group of dice such as "4d6dl1" or a constant such as "3". Sides
+// is 0 for constants.
+type Term struct {
+	Sign      int
+	Count     int
+	Sides     int
+	Constant  int
+	Explode   bool
+	Selection string
+	Select    int
+}
+
+// Parse reads dice notation. Each term is a constant or NdM, where N defaults
+// to 1 and "d%" is a d100, optionally followed by "!" to explode dice that
+// roll their maximum and by kh, kl, dh or dl and a number to keep or drop the
+// highest or lowest dice. Terms are joined with + or -.
+func Parse(notation string) (*Expression, error) {
+	text := strings.ToLower(strings.Join(strings.Fields(notation), ""))
+	if text == "" {
+		return nil, errors.New("dice notation is empty")
+	}
+
+	expression := &Expression{}
+	for text != "" {
+		sign := 1
+		switch text[0] {
+		case '+':
+			text = text[1:]
+		case '-':
+			sign = -1
+			text = text[1:]
+		default:
+			if len(expression.Terms) > 0 {
+				return nil, fmt.Errorf("expected + or - before %q", text)
+			}
+		}
+
+		end := strings.IndexAny(text, "+-")
+		if end < 0 {
+			end = len(text)
+		}
+		term, err := parseTerm(text[:end])
+		if err != nil {
+			return nil, err
+		}
+		term.Sign = sign
+		expression.Terms = append(expression.Terms, term)
+		text = text[end:]
+
+		if len(expression.Terms) > MaxTerms {
+			return nil, fmt.Errorf("dice notation has more than %d terms", MaxTerms)
+		}
+	}
+
+	return expression, nil
+}
+
+// parseTerm reads a single term without its sign
+func parseTerm(text string) (Term, error) {
+	if text == "" {
+		return Term{}, errors.New("dice notation has an empty term")
+	}
+
+	d := strings.IndexByte(text, 'd')
+	if d < 0 {
+		value, err := strconv.Atoi(text)
+		if err != nil {
+			return Term{}, fmt.Errorf("%q is not a number or dice", text)
+		}
+		return Term{Constant: value}, nil
+	}
+
+	term := Term{Count: 1}
+	if d > 0 {
+		count, err := strconv.Atoi(text[:d])
+		if err != nil || count < 1 || count > MaxDice {
+			return Term{}, fmt.Errorf("%q must roll between 1 and %d dice", text, MaxDice)
+		}
+		term.Count = count
+	}
+
+	rest := text[d+1:]
+	digits := len(rest) - len(strings.TrimLeft(rest, "0123456789"))
+	switch {
+	case strings.HasPrefix(rest, "%"):
+		term.Sides = 100
+		rest = rest[1:]
+	case digits > 0:
+		sides, err := strconv.Atoi(rest[:digits])
+		if err != nil || sides < 1 || sides > MaxSides {
+			return Term{}, fmt.Errorf("%q must have between 1 and %d sides", text, MaxSides)
+		}
+		term.Sides = sides
+		rest = rest[digits:]
+	default:
+		return Term{}, fmt.Errorf("%q is missing the number of sides", text)
+	}
+
+	if strings.HasPrefix(rest, "!") {
+		if term.Sides < 2 {
+			return Term{}, fmt.Errorf("%q cannot explode a die with fewer than 2 sides", text)
+		}
+		term.Explode = true
+		rest = rest[1:]
+	}
+
+	if rest != "" {
+		if len(rest) < 2 {
+			return Term{}, fmt.Errorf("%q has an unknown modifier %q", text, rest)
+		}
+		term.Selection = rest[:2]
+		switch term.Selection {
+		case KeepHighest, KeepLowest, DropHighest, DropLowest:
+		default:
+			return Term{}, fmt.Errorf("%q has an unknown modifier %q", text, rest)
+		}
+
+		value, err := strconv.Atoi(rest[2:])
+		if err != nil || value < 0 {
+			return Term{}, fmt.Errorf("%q must keep or drop a number of dice", text)
+		}
+		term.Select = value
+	}
+
+	return term, nil
+}
+
+// String writes the expression back out in canonical notation
+func (e *Expression) String() string {
+	var b strings.Builder
+	for i, term := range e.Terms {
+		switch {
+		case term.Sign < 0:
+			b.WriteString("-")
+		case i > 0:
+			b.WriteString("+")
+		}
+		b.WriteString(term.String())
+	}
+	return b.String()
+}
+
+// String writes the term without its sign
+func (t Term) String() string {
+	if t.Sides == 0 {
+		return strconv.Itoa(t.Constant)
+	}
+
+	notation := fmt.Sprintf("%dd%d", t.Count, t.Sides)
+	if t.Explode {
+		notation += "!"
+	}
+	if t.Selection != "" {
+		notation += t.Selection + strconv.Itoa(t.Select)
+	}
+	return notation
+}
+
+// WithMode returns a copy of the expression rolled with advantage or
+// disadvantage, which turns its single d20 into 2d20 keeping the highest or
+// lowest. An empty mode returns the expression unchanged.
+func (e *Expression) WithMode(mode string) (*Expression, error) {
+	if mode == "" {
+		return e, nil
+	}
+	if mode != Advantage && mode != Disadvantage {
+		return nil, fmt.Errorf("unknown roll mode %q", mode)
+	}
+
+	i := slices.IndexFunc(e.Terms, func(t Term) bool { return t.Sides == 20 })
+	if i < 0 || e.Terms[i].Count != 1 || e.Terms[i].Selection != "" ||
+		slices.IndexFunc(e.Terms[i+1:], func(t Term) bool { return t.Sides == 20 }) >= 0 {
+		return nil, fmt.Errorf("%s needs a roll with a single d20", mode)
+	}
+
+	rolled := &Expression{Terms: slices.Clone(e.Terms)}
+	term := &rolled.Terms[i]
+	term.Count = 2
+	term.Select = 1
+	term.Selection = KeepHighest
+	if mode == Disadvantage {
+		term.Selection = KeepLowest
+	}
+	return rolled, nil
+}
+
+// Critical returns a copy of the expression with the number of dice in each
+// group doubled, as for the damage of a critical hit
+func (e *Expression) Critical() *Expression {
+	doubled := &Expression{Terms: slices.Clone(e.Terms)}
+	for i := range doubled.Terms {
+		doubled.Terms[i].Count *= 2
+	}
+	return doubled
+}
+
+// Roll evaluates the expression with dice from roll, such as Roller.Die,
+// recording every die rolled
+func (e *Expression) Roll(roll func(int) int) *models.RollResult {
+	result := &models.RollResult{Notation: e.String(), Terms: make([]models.RollTerm, len(e.Terms))}
+	for i, term := range e.Terms {
+		rolled := term.roll(roll)
+		result.Terms[i] = rolled
+		result.Total += rolled.Sign * rolled.Total
+	}
+	return result
+}
+
+// roll evaluates a single term
+func (t Term) roll(roll func(int) int) models.RollTerm {
+	result := models.RollTerm{Notation: t.String(), Sign: t.Sign}
+	if t.Sides == 0 {
+		result.Total = t.Constant
+		return result
+	}
+
+	explosions := 0
+	for range t.Count {
+		for {
+			die := models.DieRoll{Sides: t.Sides, Value: roll(t.Sides)}
+			die.Exploded = t.Explode && die.Value == t.Sides && explosions < MaxExplosions
+			result.Dice = append(result.Dice, die)
+			if !die.Exploded {
+				break
+			}
+			explosions++
+		}
+	}
+
+	dropDice(result.Dice, t.Selection, t.Select)
+	for _, die := range result.Dice {
+		if !die.Dropped {
+			result.Total += die.Value
+		}
+	}
+	return result
+}
+
+// dropDice marks the dice removed by a keep or drop selection. Ties are broken
+// in the order the dice were rolled.
+func dropDice(dice []models.DieRoll, selection string, n int) {
+	if selection == "" {
+		return
+	}
+
+	order := make([]int, len(dice))
+	for i := range order {
+		order[i] = i
+	}
+	// Lowest first
+	slices.SortStableFunc(order, func(a, b int) int {
+		return dice[a].Value - dice[b].Value
+	})
+
+	n = min(n, len(dice))
+	var dropped []int
+	switch selection {
+	case KeepHighest:
+		dropped = order[:len(dice)-n]
+	case KeepLowest:
+		dropped = order[n:]
+	case DropHighest:
+		dropped = order[len(dice)-n:]
+	case DropLowest:
+		dropped = order[:n]
+	}
+	for _, i := range dropped {
+		dice[i].Dropped = true
+	}
+}
+
+// Roll parses and rolls notation with an optional advantage or disadvantage
+// mode
+func Roll(roll func(int) int, notation string, mode string) (*models.RollResult, error) {
+	expression, err := Parse(notation)
+	if err != nil {
+		return nil, err
+	}
+	expression, err = expression.WithMode(mode)
+	if err != nil {
+		return nil, err
+	}
+	return expression.Roll(roll), nil
+}
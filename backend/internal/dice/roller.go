@@ -0,0 +1,44 @@
+package dice
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	mathrand "math/rand/v2"
+	"sync"
+)
+
+// Roller rolls dice from a seeded generator. The same seed always produces the
+// same rolls, so tests and players can reproduce a result. A Roller is safe
+// for concurrent use.
+type Roller struct {
+	mu  sync.Mutex
+	rng *mathrand.Rand
+}
+
+// NewRoller creates a roller seeded with seed
+func NewRoller(seed uint64) *Roller {
+	return &Roller{rng: mathrand.New(mathrand.NewPCG(seed, seed))}
+}
+
+// NewRandomRoller creates a roller with a cryptographically random seed
+func NewRandomRoller() *Roller {
+	return NewRoller(NewSeed())
+}
+
+// NewSeed returns a cryptographically random seed
+func NewSeed() uint64 {
+	var buf [8]byte
+	// crypto/rand.Read never returns an error
+	_, _ = rand.Read(buf[:])
+	return binary.BigEndian.Uint64(buf[:])
+}
+
+// Die rolls a single die with the given number of sides
+func (r *Roller) Die(sides int) int {
+	if sides < 1 {
+		return 0
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.rng.IntN(sides) + 1
+}
@@ -0,0 +1,49 @@
+package dice
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FormatSeed encodes a seed as the hexadecimal string used by the API
+func FormatSeed(seed uint64) string {
+	return fmt.Sprintf("%016x", seed)
+}
+
+// ParseSeed decodes a seed produced by FormatSeed or SignSeed
+func ParseSeed(value string) (uint64, error) {
+	hexSeed, _, _ := strings.Cut(value, ".")
+	seed, err := strconv.ParseUint(hexSeed, 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("seed %q is not a valid hexadecimal seed", value)
+	}
+	return seed, nil
+}
+
+// SignSeed encodes a seed followed by its HMAC under the server's secret, so
+// rolled scores can be traced back to a seed the server issued rather than
+// one a client searched for
+func SignSeed(secret []byte, seed uint64) string {
+	encoded := FormatSeed(seed)
+	return encoded + "." + seedSignature(secret, encoded)
+}
+
+// VerifySeed checks that a seed was signed by SignSeed with the same secret
+func VerifySeed(secret []byte, value string) error {
+	encoded, signature, ok := strings.Cut(value, ".")
+	if !ok || len(secret) == 0 || !hmac.Equal([]byte(signature), []byte(seedSignature(secret, encoded))) {
+		return fmt.Errorf("seed %q was not issued by this server", value)
+	}
+	return nil
+}
+
+// seedSignature is the hexadecimal HMAC-SHA256 of an encoded seed
+func seedSignature(secret []byte, encoded string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(encoded))
+	return hex.EncodeToString(mac.Sum(nil))
+}
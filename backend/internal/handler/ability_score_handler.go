@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/dnd-character-creator/internal/logger"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/service"
+)
+
+// AbilityScoreHandler handles ability score generation HTTP requests
+type AbilityScoreHandler struct {
+	service *service.AbilityScoreService
+}
+
+// NewAbilityScoreHandler creates a new ability score handler
+func NewAbilityScoreHandler(service *service.AbilityScoreService) *AbilityScoreHandler {
+	return &AbilityScoreHandler{
+		service: service,
+	}
+}
+
+// Generate handles POST /api/v1/ability-scores/generate
+func (h *AbilityScoreHandler) Generate(c *gin.Context) {
+	var request models.AbilityScoreGenerationRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to bind ability score generation request")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "method must be one of pointBuy, standardArray or rolled",
+		})
+		return
+	}
+
+	generation, err := h.service.Generate(request.Method)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to generate ability scores")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to generate ability scores",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": generation,
+	})
+}
+
+// Validate handles POST /api/v1/ability-scores/validate
+func (h *AbilityScoreHandler) Validate(c *gin.Context) {
+	var request models.AbilityScoreValidationRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to bind ability score validation request")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid request body",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": h.service.Validate(&request),
+	})
+}
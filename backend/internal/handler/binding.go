@@ -0,0 +1,20 @@
+package handler
+
+import (
+	"errors"
+	"io"
+
+	"github.com/gin-gonic/gin"
+)
+
+// bindOptionalJSON binds a request body whose fields are all optional,
+// leaving request as its zero value when the body is empty
+func bindOptionalJSON(c *gin.Context, request any) error {
+	if c.Request.ContentLength == 0 {
+		return nil
+	}
+	if err := c.ShouldBindJSON(request); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/yourusername/dnd-character-creator/internal/logger"
@@ -87,18 +88,7 @@ func (h *CharacterHandler) Create(c *gin.Context) {
 	createdCharacter, err := h.service.Create(c.Request.Context(), &character)
 	if err != nil {
 		logger.GetLogger().WithError(err).Error("Failed to create character")
-
-		// Check for validation or duplicate name errors
-		if err.Error() == "character name already exists" {
-			c.JSON(http.StatusConflict, gin.H{
-				"error": err.Error(),
-			})
-			return
-		}
-
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": err.Error(),
-		})
+		respondError(c, err)
 		return
 	}
 
@@ -123,26 +113,7 @@ func (h *CharacterHandler) Update(c *gin.Context) {
 	updatedCharacter, err := h.service.Update(c.Request.Context(), id, &character)
 	if err != nil {
 		logger.GetLogger().WithError(err).Error("Failed to update character")
-
-		// Check for not found error
-		if err.Error() == "character not found" {
-			c.JSON(http.StatusNotFound, gin.H{
-				"error": err.Error(),
-			})
-			return
-		}
-
-		// Check for validation or duplicate name errors
-		if err.Error() == "character name already exists" {
-			c.JSON(http.StatusConflict, gin.H{
-				"error": err.Error(),
-			})
-			return
-		}
-
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": err.Error(),
-		})
+		respondError(c, err)
 		return
 	}
 
@@ -158,21 +129,419 @@ func (h *CharacterHandler) Delete(c *gin.Context) {
 	err := h.service.Delete(c.Request.Context(), id)
 	if err != nil {
 		logger.GetLogger().WithError(err).Error("Failed to delete character")
+		respondError(c, err)
+		return
+	}
 
-		if err.Error() == "character not found" {
-			c.JSON(http.StatusNotFound, gin.H{
-				"error": err.Error(),
-			})
-			return
-		}
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Character deleted successfully",
+	})
+}
 
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Failed to delete character",
+// LevelUpOptions handles GET /api/v1/characters/:id/level-up
+func (h *CharacterHandler) LevelUpOptions(c *gin.Context) {
+	class := c.Query("class")
+	if class == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "class is required",
 		})
 		return
 	}
 
+	options, err := h.service.LevelUpOptions(c.Request.Context(), c.Param("id"), class)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to get level up options")
+		respondError(c, err)
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
-		"message": "Character deleted successfully",
+		"data": options,
+	})
+}
+
+// LevelUp handles POST /api/v1/characters/:id/level-up
+func (h *CharacterHandler) LevelUp(c *gin.Context) {
+	var request models.LevelUpRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to bind level up request")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid request body",
+		})
+		return
+	}
+
+	result, err := h.service.LevelUp(c.Request.Context(), c.Param("id"), &request)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to level up character")
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": result,
+	})
+}
+
+// AwardExperience handles POST /api/v1/characters/:id/experience
+func (h *CharacterHandler) AwardExperience(c *gin.Context) {
+	var request models.ExperienceAwardRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to bind experience award")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid request body",
+		})
+		return
+	}
+
+	result, err := h.service.AwardExperience(c.Request.Context(), c.Param("id"), &request)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to award experience")
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": result,
+	})
+}
+
+// Transact handles POST /api/v1/characters/:id/currency/transactions
+func (h *CharacterHandler) Transact(c *gin.Context) {
+	var request models.CurrencyTransactionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to bind currency transaction")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid request body",
+		})
+		return
+	}
+
+	result, err := h.service.Transact(c.Request.Context(), c.Param("id"), &request)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to record currency transaction")
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"data": result,
+	})
+}
+
+// ChangeHitPoints handles POST /api/v1/characters/:id/hp
+func (h *CharacterHandler) ChangeHitPoints(c *gin.Context) {
+	var request models.HitPointChangeRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to bind hit point change")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid request body",
+		})
+		return
+	}
+
+	result, err := h.service.ChangeHitPoints(c.Request.Context(), c.Param("id"), &request)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to change hit points")
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": result,
+	})
+}
+
+// DeathSave handles POST /api/v1/characters/:id/death-save
+func (h *CharacterHandler) DeathSave(c *gin.Context) {
+	result, err := h.service.DeathSave(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to roll death save")
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": result,
+	})
+}
+
+// Cast handles POST /api/v1/characters/:id/cast
+func (h *CharacterHandler) Cast(c *gin.Context) {
+	var request models.CastRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to bind cast request")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid request body",
+		})
+		return
+	}
+
+	result, err := h.service.Cast(c.Request.Context(), c.Param("id"), &request)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to cast spell")
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": result,
+	})
+}
+
+// RollAttack handles POST /api/v1/characters/:id/attacks/:index/roll
+func (h *CharacterHandler) RollAttack(c *gin.Context) {
+	index, err := strconv.Atoi(c.Param("index"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "attack index must be a number",
+		})
+		return
+	}
+
+	var request models.AttackRollRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to bind attack roll")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid request body",
+		})
+		return
+	}
+
+	result, err := h.service.RollAttack(c.Request.Context(), c.Param("id"), index, &request)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to roll attack")
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": result,
+	})
+}
+
+// AddCondition handles POST /api/v1/characters/:id/conditions
+func (h *CharacterHandler) AddCondition(c *gin.Context) {
+	var request models.ConditionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to bind condition")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid request body",
+		})
+		return
+	}
+
+	character, err := h.service.AddCondition(c.Request.Context(), c.Param("id"), &request)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to add condition")
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": character,
+	})
+}
+
+// RemoveCondition handles DELETE /api/v1/characters/:id/conditions/:name
+func (h *CharacterHandler) RemoveCondition(c *gin.Context) {
+	character, err := h.service.RemoveCondition(c.Request.Context(), c.Param("id"), c.Param("name"))
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to remove condition")
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": character,
+	})
+}
+
+// SpendResource handles POST /api/v1/characters/:id/resources/:name/spend
+func (h *CharacterHandler) SpendResource(c *gin.Context) {
+	var request models.ResourceUseRequest
+	if err := bindOptionalJSON(c, &request); err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to bind resource use")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid request body",
+		})
+		return
+	}
+
+	character, err := h.service.SpendResource(c.Request.Context(), c.Param("id"), c.Param("name"), &request)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to spend resource")
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": character,
+	})
+}
+
+// RestoreResource handles POST /api/v1/characters/:id/resources/:name/restore
+func (h *CharacterHandler) RestoreResource(c *gin.Context) {
+	var request models.ResourceUseRequest
+	if err := bindOptionalJSON(c, &request); err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to bind resource use")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid request body",
+		})
+		return
+	}
+
+	character, err := h.service.RestoreResource(c.Request.Context(), c.Param("id"), c.Param("name"), &request)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to restore resource")
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": character,
+	})
+}
+
+// EquipItem handles POST /api/v1/characters/:id/inventory/:itemId/equip
+func (h *CharacterHandler) EquipItem(c *gin.Context) {
+	var request models.EquipRequest
+	if err := bindOptionalJSON(c, &request); err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to bind equip request")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid request body",
+		})
+		return
+	}
+
+	character, err := h.service.Equip(c.Request.Context(), c.Param("id"), c.Param("itemId"), &request)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to equip item")
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": character,
+	})
+}
+
+// UnequipItem handles POST /api/v1/characters/:id/inventory/:itemId/unequip
+func (h *CharacterHandler) UnequipItem(c *gin.Context) {
+	var request models.UnequipRequest
+	if err := bindOptionalJSON(c, &request); err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to bind unequip request")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid request body",
+		})
+		return
+	}
+
+	character, err := h.service.Unequip(c.Request.Context(), c.Param("id"), c.Param("itemId"), &request)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to unequip item")
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": character,
+	})
+}
+
+// MoveItem handles POST /api/v1/characters/:id/inventory/:itemId/move
+func (h *CharacterHandler) MoveItem(c *gin.Context) {
+	var request models.MoveItemRequest
+	if err := bindOptionalJSON(c, &request); err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to bind move request")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid request body",
+		})
+		return
+	}
+
+	character, err := h.service.MoveItem(c.Request.Context(), c.Param("id"), c.Param("itemId"), &request)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to move item")
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": character,
+	})
+}
+
+// SpendCharges handles POST /api/v1/characters/:id/inventory/:itemId/charges/spend
+func (h *CharacterHandler) SpendCharges(c *gin.Context) {
+	var request models.ItemChargeRequest
+	if err := bindOptionalJSON(c, &request); err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to bind charge request")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid request body",
+		})
+		return
+	}
+
+	character, err := h.service.SpendCharges(c.Request.Context(), c.Param("id"), c.Param("itemId"), &request)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to spend charges")
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": character,
+	})
+}
+
+// ShortRest handles POST /api/v1/characters/:id/rest/short
+func (h *CharacterHandler) ShortRest(c *gin.Context) {
+	var request models.ShortRestRequest
+	if err := bindOptionalJSON(c, &request); err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to bind short rest request")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid request body",
+		})
+		return
+	}
+
+	result, err := h.service.ShortRest(c.Request.Context(), c.Param("id"), &request)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to take short rest")
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": result,
+	})
+}
+
+// LongRest handles POST /api/v1/characters/:id/rest/long
+func (h *CharacterHandler) LongRest(c *gin.Context) {
+	result, err := h.service.LongRest(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to take long rest")
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": result,
+	})
+}
+
+// Dawn handles POST /api/v1/characters/:id/dawn
+func (h *CharacterHandler) Dawn(c *gin.Context) {
+	result, err := h.service.Dawn(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to recharge at dawn")
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": result,
 	})
 }
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/dnd-character-creator/internal/logger"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/service"
+)
+
+// DiceHandler handles dice rolling HTTP requests
+type DiceHandler struct {
+	service *service.DiceService
+}
+
+// NewDiceHandler creates a new dice handler
+func NewDiceHandler(service *service.DiceService) *DiceHandler {
+	return &DiceHandler{
+		service: service,
+	}
+}
+
+// Roll handles POST /api/v1/roll
+func (h *DiceHandler) Roll(c *gin.Context) {
+	var request models.RollRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to bind roll request")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid request body",
+		})
+		return
+	}
+
+	result, err := h.service.Roll(&request)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to roll dice")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": result,
+	})
+}
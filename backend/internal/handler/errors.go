@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/dnd-character-creator/internal/service"
+)
+
+// respondError writes the response for an error from the character or item
+// services: 404 for a missing character or item, 409 for a taken name or a
+// character that kept changing, 500 for storage failures and 400 for requests
+// the rules rejected
+func respondError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrCharacterNotFound), errors.Is(err, service.ErrItemNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+	case errors.Is(err, service.ErrCharacterNameExists), errors.Is(err, service.ErrVersionConflict):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": err.Error(),
+		})
+	case errors.Is(err, service.ErrStorage):
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Internal server error",
+		})
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+	}
+}
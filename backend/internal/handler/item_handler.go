@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/dnd-character-creator/internal/logger"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/repository"
+	"github.com/yourusername/dnd-character-creator/internal/service"
+)
+
+// ItemHandler handles homebrew item HTTP requests
+type ItemHandler struct {
+	service *service.ItemService
+}
+
+// NewItemHandler creates a new homebrew item handler
+func NewItemHandler(service *service.ItemService) *ItemHandler {
+	return &ItemHandler{
+		service: service,
+	}
+}
+
+// GetAll handles GET /api/v1/items
+func (h *ItemHandler) GetAll(c *gin.Context) {
+	filter := repository.ItemFilter{
+		Search:   c.Query("search"),
+		Category: c.Query("category"),
+	}
+
+	items, err := h.service.GetAll(c.Request.Context(), filter)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to get items")
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": items,
+	})
+}
+
+// GetByID handles GET /api/v1/items/:id
+func (h *ItemHandler) GetByID(c *gin.Context) {
+	item, err := h.service.GetByID(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to get item")
+		respondError(c, err)
+		return
+	}
+
+	if item == nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Item not found",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": item,
+	})
+}
+
+// Create handles POST /api/v1/items
+func (h *ItemHandler) Create(c *gin.Context) {
+	var item models.Item
+	if err := c.ShouldBindJSON(&item); err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to bind item")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid request body",
+		})
+		return
+	}
+
+	created, err := h.service.Create(c.Request.Context(), &item)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to create item")
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"data": created,
+	})
+}
+
+// Update handles PUT /api/v1/items/:id
+func (h *ItemHandler) Update(c *gin.Context) {
+	var item models.Item
+	if err := c.ShouldBindJSON(&item); err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to bind item")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid request body",
+		})
+		return
+	}
+
+	updated, err := h.service.Update(c.Request.Context(), c.Param("id"), &item)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to update item")
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": updated,
+	})
+}
+
+// Delete handles DELETE /api/v1/items/:id
+func (h *ItemHandler) Delete(c *gin.Context) {
+	err := h.service.Delete(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to delete item")
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Item deleted successfully",
+	})
+}
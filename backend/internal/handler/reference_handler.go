@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/dnd-character-creator/internal/logger"
+	"github.com/yourusername/dnd-character-creator/internal/reference"
+	"github.com/yourusername/dnd-character-creator/internal/service"
+)
+
+// ReferenceHandler handles SRD reference data HTTP requests
+type ReferenceHandler struct {
+	service *service.ReferenceService
+}
+
+// NewReferenceHandler creates a new reference handler
+func NewReferenceHandler(service *service.ReferenceService) *ReferenceHandler {
+	return &ReferenceHandler{
+		service: service,
+	}
+}
+
+// Kinds handles GET /api/v1/reference
+func (h *ReferenceHandler) Kinds(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"data": h.service.Kinds(),
+	})
+}
+
+// List handles GET /api/v1/reference/:kind
+func (h *ReferenceHandler) List(c *gin.Context) {
+	query := reference.Query{
+		Search:   c.Query("search"),
+		Class:    c.Query("class"),
+		Race:     c.Query("race"),
+		School:   c.Query("school"),
+		Category: c.Query("category"),
+	}
+
+	if value := c.Query("level"); value != "" {
+		level, err := strconv.Atoi(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "level must be an integer",
+			})
+			return
+		}
+		query.Level = &level
+	}
+
+	if value := c.Query("ritual"); value != "" {
+		ritual, err := strconv.ParseBool(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "ritual must be true or false",
+			})
+			return
+		}
+		query.Ritual = &ritual
+	}
+
+	if value := c.Query("concentration"); value != "" {
+		concentration, err := strconv.ParseBool(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "concentration must be true or false",
+			})
+			return
+		}
+		query.Concentration = &concentration
+	}
+
+	entries, err := h.service.List(c.Param("kind"), query)
+	if err != nil {
+		if errors.Is(err, reference.ErrUnknownKind) {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		logger.GetLogger().WithError(err).Error("Failed to list reference data")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to fetch reference data",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": entries,
+	})
+}
+
+// GetByID handles GET /api/v1/reference/:kind/:id
+func (h *ReferenceHandler) GetByID(c *gin.Context) {
+	entry, err := h.service.Get(c.Param("kind"), c.Param("id"))
+	if err != nil {
+		if errors.Is(err, reference.ErrUnknownKind) {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		logger.GetLogger().WithError(err).Error("Failed to get reference entry")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to fetch reference entry",
+		})
+		return
+	}
+
+	if entry == nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Reference entry not found",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": entry,
+	})
+}
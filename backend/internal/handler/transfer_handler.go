@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/dnd-character-creator/internal/logger"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/service"
+)
+
+// TransferHandler handles transfers of items and coins between characters
+type TransferHandler struct {
+	service *service.CharacterService
+}
+
+// NewTransferHandler creates a new transfer handler
+func NewTransferHandler(service *service.CharacterService) *TransferHandler {
+	return &TransferHandler{
+		service: service,
+	}
+}
+
+// Create handles POST /api/v1/transfers
+func (h *TransferHandler) Create(c *gin.Context) {
+	var request models.TransferRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to bind transfer")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid request body",
+		})
+		return
+	}
+
+	result, err := h.service.Transfer(c.Request.Context(), &request)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to transfer")
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"data": result,
+	})
+}
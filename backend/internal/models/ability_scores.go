@@ -0,0 +1,44 @@
+package models
+
+// AbilityScoreGenerationRequest selects how ability scores are generated
+type AbilityScoreGenerationRequest struct {
+	Method string `json:"method" binding:"required,oneof=pointBuy standardArray rolled"`
+}
+
+// AbilityScoreGeneration is a set of scores for the player to assign to
+// abilities, along with the rolls or point buy rules that produced them
+type AbilityScoreGeneration struct {
+	Method   string         `json:"method"`
+	Values   []int          `json:"values"`
+	Seed     string         `json:"seed,omitempty"`
+	Rolls    []AbilityRoll  `json:"rolls,omitempty"`
+	PointBuy *PointBuyRules `json:"pointBuy,omitempty"`
+}
+
+type AbilityRoll struct {
+	Dice    []int `json:"dice"`
+	Dropped int   `json:"dropped"`
+	Total   int   `json:"total"`
+}
+
+type PointBuyRules struct {
+	Budget  int         `json:"budget"`
+	Minimum int         `json:"minimum"`
+	Maximum int         `json:"maximum"`
+	Costs   map[int]int `json:"costs"`
+}
+
+// AbilityScoreValidationRequest declares the method a set of ability scores
+// was generated with. Seed is required for rolled scores.
+type AbilityScoreValidationRequest struct {
+	Method        string        `json:"method" binding:"required"`
+	Seed          string        `json:"seed,omitempty"`
+	AbilityScores AbilityScores `json:"abilityScores" binding:"required"`
+}
+
+// AbilityScoreValidation reports whether scores match their declared method
+type AbilityScoreValidation struct {
+	Valid       bool     `json:"valid"`
+	Errors      []string `json:"errors,omitempty"`
+	PointsSpent *int     `json:"pointsSpent,omitempty"`
+}
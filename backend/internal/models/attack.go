@@ -0,0 +1,23 @@
+package models
+
+// AttackRollRequest rolls one of the character's attacks against a target's
+// armor class. Mode adds advantage or disadvantage on top of any from the
+// character's conditions.
+type AttackRollRequest struct {
+	TargetAC int    `json:"targetAC" binding:"required,min=1,max=100"`
+	Mode     string `json:"mode,omitempty" binding:"omitempty,oneof=advantage disadvantage"`
+}
+
+// AttackRollResult is the attack roll and, on a hit, the damage roll
+type AttackRollResult struct {
+	Attack     string      `json:"attack"`
+	Mode       string      `json:"mode,omitempty"`
+	AttackRoll *RollResult `json:"attackRoll"`
+	Natural    int         `json:"natural"`
+	Total      int         `json:"total"`
+	TargetAC   int         `json:"targetAC"`
+	Hit        bool        `json:"hit"`
+	Critical   bool        `json:"critical"`
+	Damage     *RollResult `json:"damage,omitempty"`
+	DamageType string      `json:"damageType,omitempty"`
+}
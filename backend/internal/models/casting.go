@@ -0,0 +1,25 @@
+package models
+
+// CastRequest casts a spell, consuming a spell slot of SlotLevel unless it is
+// a cantrip or cast as a ritual. SlotLevel defaults to the spell's level, and
+// PactMagic spends a Pact Magic slot instead of a spell slot.
+type CastRequest struct {
+	Spell     string `json:"spell" binding:"required,max=500"`
+	SlotLevel int    `json:"slotLevel,omitempty" binding:"min=0,max=9"`
+	Ritual    bool   `json:"ritual,omitempty"`
+	PactMagic bool   `json:"pactMagic,omitempty"`
+}
+
+// CastResult is the updated character and how the spell was cast
+type CastResult struct {
+	Character          *Character `json:"character"`
+	Spell              string     `json:"spell"`
+	SpellLevel         int        `json:"spellLevel"`
+	SlotLevel          int        `json:"slotLevel,omitempty"`
+	Slot               string     `json:"slot,omitempty"`
+	SlotsRemaining     int        `json:"slotsRemaining"`
+	Upcast             bool       `json:"upcast"`
+	Ritual             bool       `json:"ritual"`
+	Concentration      bool       `json:"concentration"`
+	EndedConcentration string     `json:"endedConcentration,omitempty"`
+}
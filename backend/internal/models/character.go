@@ -6,45 +6,66 @@ import (
 
 // Character represents a D&D 5e character
 type Character struct {
-	ID                     string            `json:"_id,omitempty" bson:"_id,omitempty"`
-	CharacterName          string            `json:"characterName" bson:"characterName" binding:"required,max=500"`
-	PlayerName             string            `json:"playerName,omitempty" bson:"playerName,omitempty" binding:"max=500"`
-	Race                   string            `json:"race" bson:"race" binding:"required,max=500"`
-	Subrace                string            `json:"subrace,omitempty" bson:"subrace,omitempty" binding:"max=500"`
-	Class                  string            `json:"class" bson:"class" binding:"required,max=500"`
-	Subclass               string            `json:"subclass,omitempty" bson:"subclass,omitempty" binding:"max=500"`
-	Multiclass             []MulticlassEntry `json:"multiclass,omitempty" bson:"multiclass,omitempty"`
-	Level                  int               `json:"level" bson:"level" binding:"required,min=1,max=20"`
-	ExperiencePoints       int               `json:"experiencePoints,omitempty" bson:"experiencePoints,omitempty" binding:"min=0"`
-	Background             string            `json:"background,omitempty" bson:"background,omitempty" binding:"max=500"`
-	Alignment              string            `json:"alignment,omitempty" bson:"alignment,omitempty" binding:"max=500"`
-	AbilityScores          AbilityScores     `json:"abilityScores" bson:"abilityScores" binding:"required"`
-	SavingThrows           *SavingThrows     `json:"savingThrows,omitempty" bson:"savingThrows,omitempty"`
-	Skills                 Skills            `json:"skills" bson:"skills" binding:"required"`
-	Proficiencies          *Proficiencies    `json:"proficiencies,omitempty" bson:"proficiencies,omitempty"`
-	HitPoints              HitPoints         `json:"hitPoints" bson:"hitPoints" binding:"required"`
-	ArmorClass             int               `json:"armorClass" bson:"armorClass" binding:"required,min=0"`
-	Initiative             int               `json:"initiative" bson:"initiative" binding:"required"`
-	Speed                  Speed             `json:"speed" bson:"speed" binding:"required"`
-	Inspiration            bool              `json:"inspiration" bson:"inspiration"`
-	ProficiencyBonus       int               `json:"proficiencyBonus" bson:"proficiencyBonus" binding:"required,min=2,max=6"`
-	PassivePerception      int               `json:"passivePerception" bson:"passivePerception" binding:"required,min=0"`
-	DeathSaves             *DeathSaves       `json:"deathSaves,omitempty" bson:"deathSaves,omitempty"`
-	Attacks                []Attack          `json:"attacks,omitempty" bson:"attacks,omitempty"`
-	Inventory              *Inventory        `json:"inventory,omitempty" bson:"inventory,omitempty"`
-	Spellcasting           *Spellcasting     `json:"spellcasting,omitempty" bson:"spellcasting,omitempty"`
-	Features               []Feature         `json:"features,omitempty" bson:"features,omitempty"`
-	PersonalityTraits      []string          `json:"personalityTraits,omitempty" bson:"personalityTraits,omitempty"`
-	Ideals                 string            `json:"ideals,omitempty" bson:"ideals,omitempty" binding:"max=500"`
-	Bonds                  string            `json:"bonds,omitempty" bson:"bonds,omitempty" binding:"max=500"`
-	Flaws                  string            `json:"flaws,omitempty" bson:"flaws,omitempty" binding:"max=500"`
-	Appearance             *Appearance       `json:"appearance,omitempty" bson:"appearance,omitempty"`
-	Backstory              string            `json:"backstory,omitempty" bson:"backstory,omitempty" binding:"max=500"`
-	AlliesAndOrganizations string            `json:"alliesAndOrganizations,omitempty" bson:"alliesAndOrganizations,omitempty" binding:"max=500"`
-	Treasure               string            `json:"treasure,omitempty" bson:"treasure,omitempty" binding:"max=500"`
-	AdditionalNotes        string            `json:"additionalNotes,omitempty" bson:"additionalNotes,omitempty" binding:"max=500"`
-	CreatedAt              time.Time         `json:"createdAt" bson:"createdAt"`
-	UpdatedAt              time.Time         `json:"updatedAt" bson:"updatedAt"`
+	ID                     string                `json:"_id,omitempty" bson:"_id,omitempty"`
+	CharacterName          string                `json:"characterName" bson:"characterName" binding:"required,max=500"`
+	PlayerName             string                `json:"playerName,omitempty" bson:"playerName,omitempty" binding:"max=500"`
+	Race                   string                `json:"race" bson:"race" binding:"required,max=500"`
+	Subrace                string                `json:"subrace,omitempty" bson:"subrace,omitempty" binding:"max=500"`
+	Class                  string                `json:"class" bson:"class" binding:"required,max=500"`
+	Subclass               string                `json:"subclass,omitempty" bson:"subclass,omitempty" binding:"max=500"`
+	ClassLevel             int                   `json:"classLevel,omitempty" bson:"classLevel,omitempty" binding:"min=0,max=20"`
+	Multiclass             []MulticlassEntry     `json:"multiclass,omitempty" bson:"multiclass,omitempty"`
+	Level                  int                   `json:"level" bson:"level" binding:"required,min=1,max=20"`
+	ExperiencePoints       int                   `json:"experiencePoints,omitempty" bson:"experiencePoints,omitempty" binding:"min=0"`
+	ExperienceLedger       []ExperienceAward     `json:"experienceLedger,omitempty" bson:"experienceLedger,omitempty"`
+	CurrencyLedger         []CurrencyTransaction `json:"currencyLedger,omitempty" bson:"currencyLedger,omitempty"`
+	Transfers              []Transfer            `json:"transfers,omitempty" bson:"transfers,omitempty"`
+	Advancement            string                `json:"advancement,omitempty" bson:"advancement,omitempty" binding:"omitempty,oneof=xp milestone"`
+	LevelUpAvailable       bool                  `json:"levelUpAvailable" bson:"levelUpAvailable"`
+	Background             string                `json:"background,omitempty" bson:"background,omitempty" binding:"max=500"`
+	Alignment              string                `json:"alignment,omitempty" bson:"alignment,omitempty" binding:"max=500"`
+	AbilityScores          AbilityScores         `json:"abilityScores" bson:"abilityScores" binding:"required"`
+	SavingThrows           *SavingThrows         `json:"savingThrows,omitempty" bson:"savingThrows,omitempty"`
+	SavingThrowBonuses     SavingThrowBonuses    `json:"savingThrowBonuses" bson:"savingThrowBonuses"`
+	Skills                 Skills                `json:"skills" bson:"skills" binding:"required"`
+	Proficiencies          *Proficiencies        `json:"proficiencies,omitempty" bson:"proficiencies,omitempty"`
+	HitPoints              HitPoints             `json:"hitPoints" bson:"hitPoints" binding:"required"`
+	HitDice                []HitDice             `json:"hitDice,omitempty" bson:"hitDice,omitempty"`
+	Resources              []Resource            `json:"resources,omitempty" bson:"resources,omitempty"`
+	DamageResistances      []string              `json:"damageResistances,omitempty" bson:"damageResistances,omitempty"`
+	DamageImmunities       []string              `json:"damageImmunities,omitempty" bson:"damageImmunities,omitempty"`
+	DamageVulnerabilities  []string              `json:"damageVulnerabilities,omitempty" bson:"damageVulnerabilities,omitempty"`
+	ArmorClass             int                   `json:"armorClass" bson:"armorClass"`
+	ArmorClassBreakdown    []ArmorClassComponent `json:"armorClassBreakdown,omitempty" bson:"armorClassBreakdown,omitempty"`
+	ArmorClassEffects      *ArmorClassEffects    `json:"armorClassEffects,omitempty" bson:"armorClassEffects,omitempty"`
+	Initiative             int                   `json:"initiative" bson:"initiative"`
+	Speed                  Speed                 `json:"speed" bson:"speed" binding:"required"`
+	EffectiveSpeed         *Speed                `json:"effectiveSpeed,omitempty" bson:"effectiveSpeed,omitempty"`
+	Inspiration            bool                  `json:"inspiration" bson:"inspiration"`
+	ProficiencyBonus       int                   `json:"proficiencyBonus" bson:"proficiencyBonus"`
+	PassivePerception      int                   `json:"passivePerception" bson:"passivePerception"`
+	DeathSaves             *DeathSaves           `json:"deathSaves,omitempty" bson:"deathSaves,omitempty"`
+	Status                 string                `json:"status" bson:"status"`
+	Conditions             []Condition           `json:"conditions,omitempty" bson:"conditions,omitempty"`
+	Exhaustion             int                   `json:"exhaustion,omitempty" bson:"exhaustion,omitempty" binding:"min=0,max=6"`
+	ConditionEffects       *ConditionEffects     `json:"conditionEffects,omitempty" bson:"conditionEffects,omitempty"`
+	Attacks                []Attack              `json:"attacks,omitempty" bson:"attacks,omitempty"`
+	Inventory              *Inventory            `json:"inventory,omitempty" bson:"inventory,omitempty"`
+	Encumbrance            *Encumbrance          `json:"encumbrance,omitempty" bson:"encumbrance,omitempty"`
+	Spellcasting           *Spellcasting         `json:"spellcasting,omitempty" bson:"spellcasting,omitempty"`
+	Features               []Feature             `json:"features,omitempty" bson:"features,omitempty"`
+	PersonalityTraits      []string              `json:"personalityTraits,omitempty" bson:"personalityTraits,omitempty"`
+	Ideals                 string                `json:"ideals,omitempty" bson:"ideals,omitempty" binding:"max=500"`
+	Bonds                  string                `json:"bonds,omitempty" bson:"bonds,omitempty" binding:"max=500"`
+	Flaws                  string                `json:"flaws,omitempty" bson:"flaws,omitempty" binding:"max=500"`
+	Appearance             *Appearance           `json:"appearance,omitempty" bson:"appearance,omitempty"`
+	Backstory              string                `json:"backstory,omitempty" bson:"backstory,omitempty" binding:"max=500"`
+	AlliesAndOrganizations string                `json:"alliesAndOrganizations,omitempty" bson:"alliesAndOrganizations,omitempty" binding:"max=500"`
+	Treasure               string                `json:"treasure,omitempty" bson:"treasure,omitempty" binding:"max=500"`
+	AdditionalNotes        string                `json:"additionalNotes,omitempty" bson:"additionalNotes,omitempty" binding:"max=500"`
+	Version                int                   `json:"version" bson:"version" binding:"min=0"`
+	CreatedAt              time.Time             `json:"createdAt" bson:"createdAt"`
+	UpdatedAt              time.Time             `json:"updatedAt" bson:"updatedAt"`
 }
 
 type MulticlassEntry struct {
@@ -54,17 +75,36 @@ type MulticlassEntry struct {
 }
 
 type AbilityScore struct {
-	Score    int `json:"score" bson:"score" binding:"required,min=1,max=30"`
+	Base     int `json:"base,omitempty" bson:"base,omitempty" binding:"min=0,max=30"`
+	Score    int `json:"score" bson:"score" binding:"min=0,max=30"`
 	Modifier int `json:"modifier" bson:"modifier"`
 }
 
+type AbilityScoreIncrease struct {
+	Ability string `json:"ability" bson:"ability" binding:"required,max=500"`
+	Amount  int    `json:"amount" bson:"amount" binding:"min=1,max=2"`
+	Source  string `json:"source" bson:"source" binding:"required,oneof=race background levelUp feat"`
+	Level   int    `json:"level,omitempty" bson:"level,omitempty" binding:"min=0,max=20"`
+}
+
+type AbilityScoreOverride struct {
+	Ability string `json:"ability" bson:"ability" binding:"required,max=500"`
+	Score   int    `json:"score" bson:"score" binding:"min=1,max=30"`
+	Source  string `json:"source" bson:"source" binding:"max=500"`
+}
+
 type AbilityScores struct {
-	Strength     AbilityScore `json:"strength" bson:"strength" binding:"required"`
-	Dexterity    AbilityScore `json:"dexterity" bson:"dexterity" binding:"required"`
-	Constitution AbilityScore `json:"constitution" bson:"constitution" binding:"required"`
-	Intelligence AbilityScore `json:"intelligence" bson:"intelligence" binding:"required"`
-	Wisdom       AbilityScore `json:"wisdom" bson:"wisdom" binding:"required"`
-	Charisma     AbilityScore `json:"charisma" bson:"charisma" binding:"required"`
+	Strength     AbilityScore           `json:"strength" bson:"strength" binding:"required"`
+	Dexterity    AbilityScore           `json:"dexterity" bson:"dexterity" binding:"required"`
+	Constitution AbilityScore           `json:"constitution" bson:"constitution" binding:"required"`
+	Intelligence AbilityScore           `json:"intelligence" bson:"intelligence" binding:"required"`
+	Wisdom       AbilityScore           `json:"wisdom" bson:"wisdom" binding:"required"`
+	Charisma     AbilityScore           `json:"charisma" bson:"charisma" binding:"required"`
+	RulesVersion string                 `json:"rulesVersion,omitempty" bson:"rulesVersion,omitempty" binding:"omitempty,oneof=2014 2024"`
+	Method       string                 `json:"method,omitempty" bson:"method,omitempty" binding:"omitempty,oneof=pointBuy standardArray rolled manual"`
+	Seed         string                 `json:"seed,omitempty" bson:"seed,omitempty" binding:"max=500"`
+	Increases    []AbilityScoreIncrease `json:"increases,omitempty" bson:"increases,omitempty"`
+	Overrides    []AbilityScoreOverride `json:"overrides,omitempty" bson:"overrides,omitempty"`
 }
 
 type SavingThrows struct {
@@ -76,6 +116,15 @@ type SavingThrows struct {
 	Charisma     bool `json:"charisma" bson:"charisma"`
 }
 
+type SavingThrowBonuses struct {
+	Strength     int `json:"strength" bson:"strength"`
+	Dexterity    int `json:"dexterity" bson:"dexterity"`
+	Constitution int `json:"constitution" bson:"constitution"`
+	Intelligence int `json:"intelligence" bson:"intelligence"`
+	Wisdom       int `json:"wisdom" bson:"wisdom"`
+	Charisma     int `json:"charisma" bson:"charisma"`
+}
+
 type Skill struct {
 	Proficient bool `json:"proficient" bson:"proficient"`
 	Expertise  bool `json:"expertise" bson:"expertise"`
@@ -110,10 +159,73 @@ type Proficiencies struct {
 	Languages []string `json:"languages,omitempty" bson:"languages,omitempty"`
 }
 
+type ExperienceAward struct {
+	Amount    int       `json:"amount" bson:"amount"`
+	Total     int       `json:"total" bson:"total"`
+	Reason    string    `json:"reason" bson:"reason"`
+	Encounter string    `json:"encounter,omitempty" bson:"encounter,omitempty"`
+	AwardedAt time.Time `json:"awardedAt" bson:"awardedAt"`
+}
+
+// CurrencyTransaction is an entry in the currency ledger. Paid and Received
+// are the coins that left and entered the purse, including any change, and
+// Balance is the purse afterwards.
+type CurrencyTransaction struct {
+	Type         string    `json:"type" bson:"type"`
+	Amount       int       `json:"amount" bson:"amount"`
+	Denomination string    `json:"denomination" bson:"denomination"`
+	Memo         string    `json:"memo" bson:"memo"`
+	Paid         *Currency `json:"paid,omitempty" bson:"paid,omitempty"`
+	Received     *Currency `json:"received,omitempty" bson:"received,omitempty"`
+	Balance      Currency  `json:"balance" bson:"balance"`
+	TransactedAt time.Time `json:"transactedAt" bson:"transactedAt"`
+}
+
 type HitPoints struct {
-	Maximum   int `json:"maximum" bson:"maximum" binding:"required,min=1"`
-	Current   int `json:"current" bson:"current" binding:"required,min=0"`
-	Temporary int `json:"temporary" bson:"temporary" binding:"min=0"`
+	Maximum   int             `json:"maximum" bson:"maximum" binding:"min=0"`
+	Current   int             `json:"current" bson:"current" binding:"required,min=0"`
+	Temporary int             `json:"temporary" bson:"temporary" binding:"min=0"`
+	Reduction int             `json:"reduction,omitempty" bson:"reduction,omitempty"`
+	Growth    string          `json:"growth,omitempty" bson:"growth,omitempty" binding:"omitempty,oneof=fixed rolled"`
+	History   []HitPointLevel `json:"history,omitempty" bson:"history,omitempty"`
+}
+
+type HitPointLevel struct {
+	Level  int    `json:"level" bson:"level"`
+	Class  string `json:"class" bson:"class"`
+	HitDie int    `json:"hitDie" bson:"hitDie"`
+	Roll   int    `json:"roll" bson:"roll"`
+	Method string `json:"method" bson:"method"`
+}
+
+type HitDice struct {
+	Class string `json:"class" bson:"class" binding:"max=500"`
+	Die   int    `json:"die" bson:"die"`
+	Total int    `json:"total" bson:"total"`
+	Spent int    `json:"spent" bson:"spent" binding:"min=0"`
+}
+
+// Resource is a limited-use feature such as Rage or Ki. Class resources have
+// their maximum and recharge derived from the catalog; other resources keep
+// the values the client supplies.
+type Resource struct {
+	Name      string `json:"name" bson:"name" binding:"max=500"`
+	Source    string `json:"source,omitempty" bson:"source,omitempty" binding:"max=500"`
+	Maximum   int    `json:"maximum" bson:"maximum" binding:"min=0"`
+	Used      int    `json:"used" bson:"used" binding:"min=0"`
+	Current   int    `json:"current" bson:"current"`
+	Recharge  string `json:"recharge,omitempty" bson:"recharge,omitempty" binding:"omitempty,oneof=shortRest longRest dawn"`
+	Unlimited bool   `json:"unlimited,omitempty" bson:"unlimited,omitempty"`
+}
+
+type ArmorClassComponent struct {
+	Source string `json:"source" bson:"source"`
+	Value  int    `json:"value" bson:"value"`
+}
+
+type ArmorClassEffects struct {
+	MageArmor    bool `json:"mageArmor,omitempty" bson:"mageArmor,omitempty"`
+	NaturalArmor int  `json:"naturalArmor,omitempty" bson:"naturalArmor,omitempty" binding:"min=0"`
 }
 
 type Speed struct {
@@ -125,26 +237,79 @@ type Speed struct {
 }
 
 type DeathSaves struct {
-	Successes int `json:"successes" bson:"successes" binding:"min=0,max=3"`
-	Failures  int `json:"failures" bson:"failures" binding:"min=0,max=3"`
+	Successes int  `json:"successes" bson:"successes" binding:"min=0,max=3"`
+	Failures  int  `json:"failures" bson:"failures" binding:"min=0,max=3"`
+	Stable    bool `json:"stable,omitempty" bson:"stable,omitempty"`
+	Dead      bool `json:"dead,omitempty" bson:"dead,omitempty"`
+}
+
+type Condition struct {
+	Name     string `json:"name" bson:"name" binding:"required,max=500"`
+	Source   string `json:"source,omitempty" bson:"source,omitempty" binding:"max=500"`
+	Duration string `json:"duration,omitempty" bson:"duration,omitempty" binding:"max=500"`
+}
+
+type ConditionEffects struct {
+	AttackRolls   string   `json:"attackRolls,omitempty" bson:"attackRolls,omitempty"`
+	AbilityChecks string   `json:"abilityChecks,omitempty" bson:"abilityChecks,omitempty"`
+	SavingThrows  string   `json:"savingThrows,omitempty" bson:"savingThrows,omitempty"`
+	AutoFailSaves []string `json:"autoFailSaves,omitempty" bson:"autoFailSaves,omitempty"`
+	Incapacitated bool     `json:"incapacitated,omitempty" bson:"incapacitated,omitempty"`
+	Notes         []string `json:"notes,omitempty" bson:"notes,omitempty"`
 }
 
+// Attack is an entry on the attack list. Attacks with an ItemID are derived
+// from an equipped weapon and replaced whenever the character changes.
 type Attack struct {
 	Name        string `json:"name" bson:"name" binding:"max=500"`
 	AttackBonus int    `json:"attackBonus" bson:"attackBonus"`
 	Damage      string `json:"damage" bson:"damage" binding:"max=500"`
 	DamageType  string `json:"damageType" bson:"damageType" binding:"max=500"`
 	Notes       string `json:"notes,omitempty" bson:"notes,omitempty" binding:"max=500"`
+	ItemID      string `json:"itemId,omitempty" bson:"itemId,omitempty"`
 }
 
+// Inventory holds the character's coins and items. Items reference the
+// catalog; Weapons, Armor and Equipment are free-text entries kept for
+// characters created before items could be referenced.
 type Inventory struct {
 	Currency         *Currency       `json:"currency,omitempty" bson:"currency,omitempty"`
+	Items            []InventoryItem `json:"items,omitempty" bson:"items,omitempty"`
 	Weapons          []Weapon        `json:"weapons,omitempty" bson:"weapons,omitempty"`
 	Armor            []ArmorItem     `json:"armor,omitempty" bson:"armor,omitempty"`
 	Equipment        []EquipmentItem `json:"equipment,omitempty" bson:"equipment,omitempty"`
 	CarryingCapacity int             `json:"carryingCapacity,omitempty" bson:"carryingCapacity,omitempty" binding:"min=0"`
 }
 
+// InventoryItem is an item held by the character, referencing an SRD or
+// homebrew item by ItemID. Name, Charges and Notes belong to this one item;
+// Item holds the catalog stats, resolved whenever the character is read and
+// never stored. ContainerID is the inventory ID of the container the item is
+// packed in, if any, and ContentsWeight is derived for containers from what
+// they hold.
+type InventoryItem struct {
+	ID             string  `json:"id" bson:"id" binding:"max=100"`
+	ItemID         string  `json:"itemId" bson:"itemId" binding:"required,max=100"`
+	Quantity       int     `json:"quantity" bson:"quantity" binding:"min=0"`
+	Equipped       bool    `json:"equipped" bson:"equipped"`
+	Attuned        bool    `json:"attuned,omitempty" bson:"attuned,omitempty"`
+	Name           string  `json:"name,omitempty" bson:"name,omitempty" binding:"max=500"`
+	Charges        *int    `json:"charges,omitempty" bson:"charges,omitempty" binding:"omitempty,min=0"`
+	Notes          string  `json:"notes,omitempty" bson:"notes,omitempty" binding:"max=500"`
+	ContainerID    string  `json:"containerId,omitempty" bson:"containerId,omitempty" binding:"max=100"`
+	ContentsWeight float64 `json:"contentsWeight,omitempty" bson:"contentsWeight,omitempty"`
+	Item           *Item   `json:"item,omitempty" bson:"-"`
+}
+
+type Encumbrance struct {
+	CarriedWeight              float64 `json:"carriedWeight" bson:"carriedWeight"`
+	CarryingCapacity           float64 `json:"carryingCapacity" bson:"carryingCapacity"`
+	EncumberedThreshold        float64 `json:"encumberedThreshold" bson:"encumberedThreshold"`
+	HeavilyEncumberedThreshold float64 `json:"heavilyEncumberedThreshold" bson:"heavilyEncumberedThreshold"`
+	Status                     string  `json:"status" bson:"status"`
+	SpeedPenalty               int     `json:"speedPenalty" bson:"speedPenalty"`
+}
+
 type Currency struct {
 	Copper   int `json:"copper" bson:"copper" binding:"min=0"`
 	Silver   int `json:"silver" bson:"silver" binding:"min=0"`
@@ -161,14 +326,17 @@ type Weapon struct {
 	Properties []string `json:"properties,omitempty" bson:"properties,omitempty"`
 	Equipped   bool     `json:"equipped" bson:"equipped"`
 	Quantity   int      `json:"quantity" bson:"quantity" binding:"min=1"`
+	Weight     float64  `json:"weight,omitempty" bson:"weight,omitempty" binding:"min=0"`
 }
 
 type ArmorItem struct {
-	Name                string `json:"name" bson:"name" binding:"max=500"`
-	Type                string `json:"type" bson:"type" binding:"max=500"`
-	ArmorClass          int    `json:"armorClass" bson:"armorClass" binding:"min=0"`
-	Equipped            bool   `json:"equipped" bson:"equipped"`
-	StealthDisadvantage bool   `json:"stealthDisadvantage" bson:"stealthDisadvantage"`
+	Name                string  `json:"name" bson:"name" binding:"max=500"`
+	Type                string  `json:"type" bson:"type" binding:"max=500"`
+	ArmorClass          int     `json:"armorClass" bson:"armorClass" binding:"min=0"`
+	Equipped            bool    `json:"equipped" bson:"equipped"`
+	StealthDisadvantage bool    `json:"stealthDisadvantage" bson:"stealthDisadvantage"`
+	StrengthRequirement int     `json:"strengthRequirement,omitempty" bson:"strengthRequirement,omitempty" binding:"min=0,max=30"`
+	Weight              float64 `json:"weight,omitempty" bson:"weight,omitempty" binding:"min=0"`
 }
 
 type EquipmentItem struct {
@@ -179,13 +347,20 @@ type EquipmentItem struct {
 }
 
 type Spellcasting struct {
-	SpellcastingAbility string      `json:"spellcastingAbility,omitempty" bson:"spellcastingAbility,omitempty" binding:"max=500"`
-	SpellSaveDC         int         `json:"spellSaveDC,omitempty" bson:"spellSaveDC,omitempty" binding:"min=0"`
-	SpellAttackBonus    int         `json:"spellAttackBonus,omitempty" bson:"spellAttackBonus,omitempty"`
-	SpellSlots          *SpellSlots `json:"spellSlots,omitempty" bson:"spellSlots,omitempty"`
-	CantripsKnown       []string    `json:"cantripsKnown,omitempty" bson:"cantripsKnown,omitempty"`
-	SpellsKnown         []string    `json:"spellsKnown,omitempty" bson:"spellsKnown,omitempty"`
-	PreparedSpells      []string    `json:"preparedSpells,omitempty" bson:"preparedSpells,omitempty"`
+	SpellcastingAbility string         `json:"spellcastingAbility,omitempty" bson:"spellcastingAbility,omitempty" binding:"max=500"`
+	SpellSaveDC         int            `json:"spellSaveDC,omitempty" bson:"spellSaveDC,omitempty" binding:"min=0"`
+	SpellAttackBonus    int            `json:"spellAttackBonus,omitempty" bson:"spellAttackBonus,omitempty"`
+	SpellSlots          *SpellSlots    `json:"spellSlots,omitempty" bson:"spellSlots,omitempty"`
+	PactMagic           *PactMagic     `json:"pactMagic,omitempty" bson:"pactMagic,omitempty"`
+	CantripsKnown       []string       `json:"cantripsKnown,omitempty" bson:"cantripsKnown,omitempty"`
+	SpellsKnown         []string       `json:"spellsKnown,omitempty" bson:"spellsKnown,omitempty"`
+	PreparedSpells      []string       `json:"preparedSpells,omitempty" bson:"preparedSpells,omitempty"`
+	Concentration       *Concentration `json:"concentration,omitempty" bson:"concentration,omitempty"`
+}
+
+type Concentration struct {
+	Spell     string `json:"spell" bson:"spell" binding:"max=500"`
+	SlotLevel int    `json:"slotLevel" bson:"slotLevel" binding:"min=0,max=9"`
 }
 
 type SpellSlots struct {
@@ -205,6 +380,12 @@ type SpellSlotLevel struct {
 	Used  int `json:"used" bson:"used" binding:"min=0"`
 }
 
+type PactMagic struct {
+	SlotLevel int `json:"slotLevel" bson:"slotLevel" binding:"min=0,max=5"`
+	Total     int `json:"total" bson:"total" binding:"min=0"`
+	Used      int `json:"used" bson:"used" binding:"min=0"`
+}
+
 type Feature struct {
 	Name        string `json:"name" bson:"name" binding:"max=500"`
 	Source      string `json:"source" bson:"source" binding:"max=500"`
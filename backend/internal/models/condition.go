@@ -0,0 +1,10 @@
+package models
+
+// ConditionRequest adds a condition to a character. Levels applies only to
+// exhaustion and defaults to one.
+type ConditionRequest struct {
+	Name     string `json:"name" binding:"required,max=500"`
+	Source   string `json:"source,omitempty" binding:"max=500"`
+	Duration string `json:"duration,omitempty" binding:"max=500"`
+	Levels   int    `json:"levels,omitempty" binding:"min=0,max=6"`
+}
@@ -0,0 +1,17 @@
+package models
+
+// CurrencyTransactionRequest credits coins to or debits coins from a
+// character's purse
+type CurrencyTransactionRequest struct {
+	Type         string `json:"type" binding:"required,oneof=credit debit"`
+	Amount       int    `json:"amount" binding:"required,min=1,max=1000000"`
+	Denomination string `json:"denomination" binding:"required,oneof=cp sp ep gp pp"`
+	Memo         string `json:"memo" binding:"required,max=500"`
+}
+
+// CurrencyTransactionResult is the updated character and the ledger entry
+// recorded for the transaction
+type CurrencyTransactionResult struct {
+	Character   *Character          `json:"character"`
+	Transaction CurrencyTransaction `json:"transaction"`
+}
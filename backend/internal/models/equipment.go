@@ -0,0 +1,26 @@
+package models
+
+// EquipRequest equips an inventory item. Attune also attunes to a magic item
+// that requires it.
+type EquipRequest struct {
+	Attune bool `json:"attune,omitempty"`
+}
+
+// UnequipRequest unequips an inventory item. EndAttunement also ends
+// attunement to it, which otherwise lasts until the character chooses to end it.
+type UnequipRequest struct {
+	EndAttunement bool `json:"endAttunement,omitempty"`
+}
+
+// ItemChargeRequest spends charges of a magic item, one when Amount is 0
+type ItemChargeRequest struct {
+	Amount int `json:"amount,omitempty" binding:"min=0,max=100"`
+}
+
+// MoveItemRequest packs an inventory item into the container with the
+// inventory ID ContainerID, or takes it out of its container when ContainerID
+// is empty. Quantity moves part of a stack; 0 moves all of it.
+type MoveItemRequest struct {
+	ContainerID string `json:"containerId,omitempty" binding:"max=100"`
+	Quantity    int    `json:"quantity,omitempty" binding:"min=0,max=1000000"`
+}
@@ -0,0 +1,20 @@
+package models
+
+// ExperienceAwardRequest awards experience points to a character. Negative
+// amounts correct earlier awards.
+type ExperienceAwardRequest struct {
+	Amount    int    `json:"amount" binding:"required"`
+	Reason    string `json:"reason" binding:"required,max=500"`
+	Encounter string `json:"encounter,omitempty" binding:"max=500"`
+}
+
+// ExperienceAwardResult is the updated character and where its experience
+// total now stands against the level thresholds
+type ExperienceAwardResult struct {
+	Character           *Character      `json:"character"`
+	Award               ExperienceAward `json:"award"`
+	ThresholdCrossed    bool            `json:"thresholdCrossed"`
+	LevelUpAvailable    bool            `json:"levelUpAvailable"`
+	EligibleLevel       int             `json:"eligibleLevel"`
+	NextLevelExperience int             `json:"nextLevelExperience,omitempty"`
+}
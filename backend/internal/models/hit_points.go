@@ -0,0 +1,34 @@
+package models
+
+// HitPointChangeRequest applies damage, healing or temporary hit points to a
+// character
+type HitPointChangeRequest struct {
+	Type       string `json:"type" binding:"required,oneof=damage healing temporary"`
+	Amount     int    `json:"amount" binding:"min=0"`
+	DamageType string `json:"damageType,omitempty" binding:"max=500"`
+	Critical   bool   `json:"critical,omitempty"`
+}
+
+// HitPointChangeResult is the updated character and how the change was
+// resolved
+type HitPointChangeResult struct {
+	Character         *Character `json:"character"`
+	Type              string     `json:"type"`
+	Amount            int        `json:"amount"`
+	Adjusted          int        `json:"adjusted"`
+	Adjustment        string     `json:"adjustment,omitempty"`
+	TemporaryAbsorbed int        `json:"temporaryAbsorbed,omitempty"`
+	Change            int        `json:"change"`
+	Dying             bool       `json:"dying"`
+	Dead              bool       `json:"dead"`
+	InstantDeath      bool       `json:"instantDeath,omitempty"`
+}
+
+// DeathSaveResult is the updated character and the outcome of a death saving
+// throw
+type DeathSaveResult struct {
+	Character *Character `json:"character"`
+	Roll      int        `json:"roll"`
+	Outcome   string     `json:"outcome"`
+	Status    string     `json:"status"`
+}
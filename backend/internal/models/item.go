@@ -0,0 +1,77 @@
+package models
+
+// Item is the stats of a weapon, armor or gear item, either from the SRD
+// catalog or a homebrew item shared by every character that holds it.
+// Container is set for items that hold other items and Magic for magic items.
+type Item struct {
+	ID                  string         `json:"id" bson:"_id,omitempty"`
+	Name                string         `json:"name" bson:"name" binding:"required,max=500"`
+	Source              string         `json:"source" bson:"source"`
+	Category            string         `json:"category" bson:"category" binding:"required,oneof=weapon armor gear"`
+	Description         string         `json:"description,omitempty" bson:"description,omitempty" binding:"max=2000"`
+	Weight              float64        `json:"weight" bson:"weight" binding:"min=0"`
+	Cost                *ItemCost      `json:"cost,omitempty" bson:"cost,omitempty"`
+	WeaponCategory      string         `json:"weaponCategory,omitempty" bson:"weaponCategory,omitempty" binding:"max=500"`
+	Damage              string         `json:"damage,omitempty" bson:"damage,omitempty" binding:"max=200"`
+	VersatileDamage     string         `json:"versatileDamage,omitempty" bson:"versatileDamage,omitempty" binding:"max=200"`
+	DamageType          string         `json:"damageType,omitempty" bson:"damageType,omitempty" binding:"max=500"`
+	Properties          []string       `json:"properties,omitempty" bson:"properties,omitempty"`
+	ArmorCategory       string         `json:"armorCategory,omitempty" bson:"armorCategory,omitempty" binding:"omitempty,oneof=light medium heavy shield"`
+	BaseArmorClass      int            `json:"baseArmorClass,omitempty" bson:"baseArmorClass,omitempty" binding:"min=0,max=30"`
+	DexBonus            bool           `json:"dexBonus,omitempty" bson:"dexBonus,omitempty"`
+	MaxDexBonus         *int           `json:"maxDexBonus,omitempty" bson:"maxDexBonus,omitempty"`
+	StrengthRequirement int            `json:"strengthRequirement,omitempty" bson:"strengthRequirement,omitempty" binding:"min=0,max=30"`
+	StealthDisadvantage bool           `json:"stealthDisadvantage,omitempty" bson:"stealthDisadvantage,omitempty"`
+	Container           *ItemContainer `json:"container,omitempty" bson:"container,omitempty"`
+	Magic               *MagicItem     `json:"magic,omitempty" bson:"magic,omitempty"`
+}
+
+// ItemCost is a price in a single coin denomination
+type ItemCost struct {
+	Quantity int    `json:"quantity" bson:"quantity" binding:"min=0"`
+	Unit     string `json:"unit" bson:"unit" binding:"oneof=cp sp ep gp pp"`
+}
+
+// ItemContainer is how many pounds of items a container such as a backpack
+// can hold. What a weightless container such as a bag of holding holds
+// doesn't add to the weight its holder carries.
+type ItemContainer struct {
+	Capacity   float64 `json:"capacity" bson:"capacity" binding:"gt=0,max=100000"`
+	Weightless bool    `json:"weightless,omitempty" bson:"weightless,omitempty"`
+}
+
+// MagicItem is what makes an item magic. Its modifiers only work while the
+// item is equipped and, when it requires attunement, attuned.
+type MagicItem struct {
+	Rarity             string                   `json:"rarity" bson:"rarity" binding:"required,oneof=common uncommon rare 'very rare' legendary artifact"`
+	RequiresAttunement bool                     `json:"requiresAttunement,omitempty" bson:"requiresAttunement,omitempty"`
+	Prerequisites      *AttunementPrerequisites `json:"prerequisites,omitempty" bson:"prerequisites,omitempty"`
+	Charges            *ItemCharges             `json:"charges,omitempty" bson:"charges,omitempty"`
+	Modifiers          []ItemModifier           `json:"modifiers,omitempty" bson:"modifiers,omitempty" binding:"max=20,dive"`
+}
+
+// AttunementPrerequisites limits who can attune to a magic item. A character
+// must meet every prerequisite that is set, matching any one of the listed
+// classes or races.
+type AttunementPrerequisites struct {
+	Classes     []string `json:"classes,omitempty" bson:"classes,omitempty" binding:"max=12"`
+	Races       []string `json:"races,omitempty" bson:"races,omitempty" binding:"max=12"`
+	Spellcaster bool     `json:"spellcaster,omitempty" bson:"spellcaster,omitempty"`
+}
+
+// ItemCharges is how many charges a magic item holds and when it regains them.
+// Regain is dice notation for the charges regained at dawn; other recharges
+// restore every charge.
+type ItemCharges struct {
+	Maximum  int    `json:"maximum" bson:"maximum" binding:"min=1,max=100"`
+	Recharge string `json:"recharge,omitempty" bson:"recharge,omitempty" binding:"omitempty,oneof=dawn shortRest longRest"`
+	Regain   string `json:"regain,omitempty" bson:"regain,omitempty" binding:"max=50"`
+}
+
+// ItemModifier changes a derived stat, such as +1 to armorClass, or sets an
+// ability score, such as strength to 19. Add is the default operation.
+type ItemModifier struct {
+	Target    string `json:"target" bson:"target" binding:"required,max=50"`
+	Operation string `json:"operation,omitempty" bson:"operation,omitempty" binding:"omitempty,oneof=add set"`
+	Value     int    `json:"value" bson:"value" binding:"min=-30,max=30"`
+}
@@ -0,0 +1,44 @@
+package models
+
+// LevelUpRequest advances a character by one level in a class along with the
+// choices that level requires
+type LevelUpRequest struct {
+	Class                 string            `json:"class" binding:"required,max=500"`
+	Subclass              string            `json:"subclass,omitempty" binding:"max=500"`
+	AbilityScoreIncreases []LevelUpIncrease `json:"abilityScoreIncreases,omitempty" binding:"dive"`
+	Feat                  string            `json:"feat,omitempty" binding:"max=500"`
+	Cantrips              []string          `json:"cantrips,omitempty"`
+	Spells                []string          `json:"spells,omitempty"`
+}
+
+// LevelUpIncrease is one ability raised by an Ability Score Improvement
+type LevelUpIncrease struct {
+	Ability string `json:"ability" binding:"required,max=500"`
+	Amount  int    `json:"amount" binding:"min=1,max=2"`
+}
+
+// LevelUpOptions describes what a character gains and must choose when taking
+// the next level in a class
+type LevelUpOptions struct {
+	Class                   string   `json:"class"`
+	ClassLevel              int      `json:"classLevel"`
+	Level                   int      `json:"level"`
+	Multiclassing           bool     `json:"multiclassing"`
+	HitDie                  int      `json:"hitDie"`
+	Features                []string `json:"features,omitempty"`
+	SubclassRequired        bool     `json:"subclassRequired"`
+	Subclasses              []string `json:"subclasses,omitempty"`
+	AbilityScoreImprovement bool     `json:"abilityScoreImprovement"`
+	Cantrips                int      `json:"cantrips"`
+	Spells                  int      `json:"spells"`
+	MaxSpellLevel           int      `json:"maxSpellLevel"`
+}
+
+// LevelUpResult is the updated character and a summary of what changed
+type LevelUpResult struct {
+	Character        *Character     `json:"character"`
+	Options          LevelUpOptions `json:"options"`
+	HitPoints        HitPointLevel  `json:"hitPoints"`
+	HitPointIncrease int            `json:"hitPointIncrease"`
+	Features         []Feature      `json:"features,omitempty"`
+}
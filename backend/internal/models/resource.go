@@ -0,0 +1,7 @@
+package models
+
+// ResourceUseRequest spends or restores uses of a resource. Spending defaults
+// to one use and restoring defaults to all of them.
+type ResourceUseRequest struct {
+	Amount int `json:"amount,omitempty" binding:"min=0,max=100"`
+}
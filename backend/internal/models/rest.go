@@ -0,0 +1,34 @@
+package models
+
+// ShortRestRequest spends hit dice during a short rest. Each die heals its
+// roll, or its average when Method is "average", plus the Constitution
+// modifier.
+type ShortRestRequest struct {
+	HitDice []HitDiceSpend `json:"hitDice,omitempty"`
+	Method  string         `json:"method,omitempty" binding:"omitempty,oneof=rolled average"`
+}
+
+// HitDiceSpend is a number of hit dice spent from one class's pool
+type HitDiceSpend struct {
+	Class string `json:"class" binding:"required,max=500"`
+	Count int    `json:"count" binding:"min=1,max=20"`
+}
+
+// HitDieRoll is the healing from a single hit die spent on a short rest
+type HitDieRoll struct {
+	Class   string `json:"class"`
+	Die     int    `json:"die"`
+	Roll    int    `json:"roll"`
+	Healing int    `json:"healing"`
+}
+
+// RestResult is the updated character and what a rest or the dawn restored
+type RestResult struct {
+	Character          *Character   `json:"character"`
+	Rolls              []HitDieRoll `json:"rolls,omitempty"`
+	HitPointsRegained  int          `json:"hitPointsRegained"`
+	HitDiceRecovered   int          `json:"hitDiceRecovered,omitempty"`
+	SpellSlotsRestored int          `json:"spellSlotsRestored"`
+	ResourcesRestored  []string     `json:"resourcesRestored,omitempty"`
+	ItemsRecharged     []string     `json:"itemsRecharged,omitempty"`
+}
@@ -0,0 +1,35 @@
+package models
+
+// RollRequest rolls dice written in standard notation such as "1d8+3",
+// "4d6dl1" or "1d6!". Passing the seed from an earlier result repeats it.
+type RollRequest struct {
+	Notation string `json:"notation" binding:"required,max=200"`
+	Mode     string `json:"mode,omitempty" binding:"omitempty,oneof=advantage disadvantage"`
+	Seed     string `json:"seed,omitempty" binding:"max=16"`
+}
+
+// RollResult is the total of a roll along with every die that was rolled
+type RollResult struct {
+	Notation string     `json:"notation"`
+	Seed     string     `json:"seed,omitempty"`
+	Total    int        `json:"total"`
+	Terms    []RollTerm `json:"terms"`
+}
+
+// RollTerm is one term of a roll, either a group of dice or a constant
+// modifier. Sign is -1 for subtracted terms.
+type RollTerm struct {
+	Notation string    `json:"notation"`
+	Sign     int       `json:"sign"`
+	Dice     []DieRoll `json:"dice,omitempty"`
+	Total    int       `json:"total"`
+}
+
+// DieRoll is a single die. Dropped dice don't count towards the total, and
+// exploded dice rolled their maximum and added another die.
+type DieRoll struct {
+	Sides    int  `json:"sides"`
+	Value    int  `json:"value"`
+	Dropped  bool `json:"dropped,omitempty"`
+	Exploded bool `json:"exploded,omitempty"`
+}
@@ -0,0 +1,58 @@
+package models
+
+import "time"
+
+// TransferRequest moves inventory items and coins from one character to
+// another. Either all of it moves or none of it does.
+type TransferRequest struct {
+	FromCharacterID string                `json:"fromCharacterId" binding:"required,max=100"`
+	ToCharacterID   string                `json:"toCharacterId" binding:"required,max=100,nefield=FromCharacterID"`
+	Items           []TransferItemRequest `json:"items,omitempty" binding:"max=50,dive"`
+	Currency        *TransferCurrency     `json:"currency,omitempty"`
+	Memo            string                `json:"memo,omitempty" binding:"max=500"`
+}
+
+// TransferItemRequest names an item in the sender's inventory by its
+// inventory ID and how many of it to give. A Quantity of 0 gives them all.
+type TransferItemRequest struct {
+	ID       string `json:"id" binding:"required,max=100"`
+	Quantity int    `json:"quantity,omitempty" binding:"min=0,max=1000000"`
+}
+
+// TransferCurrency is an amount of coins given. The sender pays it like a
+// debit, making change as needed.
+type TransferCurrency struct {
+	Amount       int    `json:"amount" bson:"amount" binding:"required,min=1,max=1000000"`
+	Denomination string `json:"denomination" bson:"denomination" binding:"required,oneof=cp sp ep gp pp"`
+}
+
+// Transfer is an entry in a character's transfer history. Both characters
+// record the same transfer under the same ID, one as sent and one as
+// received.
+type Transfer struct {
+	ID            string            `json:"id" bson:"id"`
+	Direction     string            `json:"direction" bson:"direction"`
+	CharacterID   string            `json:"characterId" bson:"characterId"`
+	CharacterName string            `json:"characterName" bson:"characterName"`
+	Items         []TransferredItem `json:"items,omitempty" bson:"items,omitempty"`
+	Currency      *TransferCurrency `json:"currency,omitempty" bson:"currency,omitempty"`
+	Memo          string            `json:"memo,omitempty" bson:"memo,omitempty"`
+	TransferredAt time.Time         `json:"transferredAt" bson:"transferredAt"`
+}
+
+// TransferredItem is an item moved by a transfer. ID is the item's inventory
+// ID on the receiving character.
+type TransferredItem struct {
+	ID       string `json:"id" bson:"id"`
+	ItemID   string `json:"itemId,omitempty" bson:"itemId,omitempty"`
+	Name     string `json:"name" bson:"name"`
+	Quantity int    `json:"quantity" bson:"quantity"`
+}
+
+// TransferResult is both updated characters and the transfer as the sender
+// recorded it
+type TransferResult struct {
+	From     *Character `json:"from"`
+	To       *Character `json:"to"`
+	Transfer Transfer   `json:"transfer"`
+}
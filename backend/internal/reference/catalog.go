@@ -0,0 +1,342 @@
+package reference
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+//go:embed data/*.json
+var dataFS embed.FS
+
+// Kind identifies a category of reference data
+type Kind string
+
+const (
+	KindRaces       Kind = "races"
+	KindSubraces    Kind = "subraces"
+	KindClasses     Kind = "classes"
+	KindSubclasses  Kind = "subclasses"
+	KindBackgrounds Kind = "backgrounds"
+	KindSpells      Kind = "spells"
+	KindEquipment   Kind = "equipment"
+	KindFeats       Kind = "feats"
+)
+
+// Kinds lists every kind of reference data served by the catalog
+var Kinds = []Kind{
+	KindRaces,
+	KindSubraces,
+	KindClasses,
+	KindSubclasses,
+	KindBackgrounds,
+	KindSpells,
+	KindEquipment,
+	KindFeats,
+}
+
+// ErrUnknownKind is returned when a kind is not part of the catalog
+var ErrUnknownKind = errors.New("unknown reference kind")
+
+// Query holds search and filtering criteria for catalog listings.
+// Filters that do not apply to the requested kind are ignored.
+type Query struct {
+	Search        string
+	Class         string
+	Race          string
+	School        string
+	Category      string
+	Level         *int
+	Ritual        *bool
+	Concentration *bool
+}
+
+// Catalog is the read-only SRD 5.1 reference catalog
+type Catalog struct {
+	races       []Race
+	subraces    []Subrace
+	classes     []Class
+	subclasses  []Subclass
+	backgrounds []Background
+	spells      []Spell
+	equipment   []Equipment
+	feats       []Feat
+}
+
+// Load reads the embedded SRD data files into a catalog
+func Load() (*Catalog, error) {
+	c := &Catalog{}
+
+	files := map[Kind]interface{}{
+		KindRaces:       &c.races,
+		KindSubraces:    &c.subraces,
+		KindClasses:     &c.classes,
+		KindSubclasses:  &c.subclasses,
+		KindBackgrounds: &c.backgrounds,
+		KindSpells:      &c.spells,
+		KindEquipment:   &c.equipment,
+		KindFeats:       &c.feats,
+	}
+
+	for kind, target := range files {
+		data, err := dataFS.ReadFile("data/" + string(kind) + ".json")
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s reference data: %w", kind, err)
+		}
+		if err := json.Unmarshal(data, target); err != nil {
+			return nil, fmt.Errorf("failed to parse %s reference data: %w", kind, err)
+		}
+	}
+
+	return c, nil
+}
+
+// ParseKind converts a string into a known Kind
+func ParseKind(value string) (Kind, error) {
+	for _, kind := range Kinds {
+		if string(kind) == strings.ToLower(value) {
+			return kind, nil
+		}
+	}
+	return "", ErrUnknownKind
+}
+
+// List returns all entries of a kind matching the query
+func (c *Catalog) List(kind Kind, query Query) ([]interface{}, error) {
+	results := []interface{}{}
+
+	switch kind {
+	case KindRaces:
+		for i := range c.races {
+			if matchesSearch(c.races[i].Name, query) {
+				results = append(results, c.races[i])
+			}
+		}
+	case KindSubraces:
+		for i := range c.subraces {
+			s := &c.subraces[i]
+			if matchesSearch(s.Name, query) && matchesRef(s.Race, query.Race, c.raceID) {
+				results = append(results, *s)
+			}
+		}
+	case KindClasses:
+		for i := range c.classes {
+			if matchesSearch(c.classes[i].Name, query) {
+				results = append(results, c.classes[i])
+			}
+		}
+	case KindSubclasses:
+		for i := range c.subclasses {
+			s := &c.subclasses[i]
+			if matchesSearch(s.Name, query) && matchesRef(s.Class, query.Class, c.classID) {
+				results = append(results, *s)
+			}
+		}
+	case KindBackgrounds:
+		for i := range c.backgrounds {
+			if matchesSearch(c.backgrounds[i].Name, query) {
+				results = append(results, c.backgrounds[i])
+			}
+		}
+	case KindSpells:
+		for i := range c.spells {
+			if c.matchesSpell(&c.spells[i], query) {
+				results = append(results, c.spells[i])
+			}
+		}
+	case KindEquipment:
+		for i := range c.equipment {
+			e := &c.equipment[i]
+			if matchesSearch(e.Name, query) && (query.Category == "" || strings.EqualFold(e.Category, query.Category)) {
+				results = append(results, *e)
+			}
+		}
+	case KindFeats:
+		for i := range c.feats {
+			if matchesSearch(c.feats[i].Name, query) {
+				results = append(results, c.feats[i])
+			}
+		}
+	default:
+		return nil, ErrUnknownKind
+	}
+
+	return results, nil
+}
+
+// Get returns a single entry of a kind by ID or name, or nil if not found
+func (c *Catalog) Get(kind Kind, key string) (interface{}, error) {
+	var entry interface{}
+	var found bool
+
+	switch kind {
+	case KindRaces:
+		entry, found = c.Race(key)
+	case KindSubraces:
+		entry, found = c.Subrace(key)
+	case KindClasses:
+		entry, found = c.Class(key)
+	case KindSubclasses:
+		entry, found = c.Subclass(key)
+	case KindBackgrounds:
+		entry, found = c.Background(key)
+	case KindSpells:
+		entry, found = c.Spell(key)
+	case KindEquipment:
+		entry, found = c.Equipment(key)
+	case KindFeats:
+		entry, found = c.Feat(key)
+	default:
+		return nil, ErrUnknownKind
+	}
+
+	if !found {
+		return nil, nil
+	}
+	return entry, nil
+}
+
+// Race looks up a race by ID or name
+func (c *Catalog) Race(key string) (*Race, bool) {
+	for i := range c.races {
+		if matchesKey(c.races[i].ID, c.races[i].Name, key) {
+			return &c.races[i], true
+		}
+	}
+	return nil, false
+}
+
+// Subrace looks up a subrace by ID or name
+func (c *Catalog) Subrace(key string) (*Subrace, bool) {
+	for i := range c.subraces {
+		if matchesKey(c.subraces[i].ID, c.subraces[i].Name, key) {
+			return &c.subraces[i], true
+		}
+	}
+	return nil, false
+}
+
+// Class looks up a class by ID or name
+func (c *Catalog) Class(key string) (*Class, bool) {
+	for i := range c.classes {
+		if matchesKey(c.classes[i].ID, c.classes[i].Name, key) {
+			return &c.classes[i], true
+		}
+	}
+	return nil, false
+}
+
+// Subclass looks up a subclass by ID or name
+func (c *Catalog) Subclass(key string) (*Subclass, bool) {
+	for i := range c.subclasses {
+		if matchesKey(c.subclasses[i].ID, c.subclasses[i].Name, key) {
+			return &c.subclasses[i], true
+		}
+	}
+	return nil, false
+}
+
+// Background looks up a background by ID or name
+func (c *Catalog) Background(key string) (*Background, bool) {
+	for i := range c.backgrounds {
+		if matchesKey(c.backgrounds[i].ID, c.backgrounds[i].Name, key) {
+			return &c.backgrounds[i], true
+		}
+	}
+	return nil, false
+}
+
+// Spell looks up a spell by ID or name
+func (c *Catalog) Spell(key string) (*Spell, bool) {
+	for i := range c.spells {
+		if matchesKey(c.spells[i].ID, c.spells[i].Name, key) {
+			return &c.spells[i], true
+		}
+	}
+	return nil, false
+}
+
+// Equipment looks up an equipment item by ID or name
+func (c *Catalog) Equipment(key string) (*Equipment, bool) {
+	for i := range c.equipment {
+		if matchesKey(c.equipment[i].ID, c.equipment[i].Name, key) {
+			return &c.equipment[i], true
+		}
+	}
+	return nil, false
+}
+
+// Feat looks up a feat by ID or name
+func (c *Catalog) Feat(key string) (*Feat, bool) {
+	for i := range c.feats {
+		if matchesKey(c.feats[i].ID, c.feats[i].Name, key) {
+			return &c.feats[i], true
+		}
+	}
+	return nil, false
+}
+
+func (c *Catalog) matchesSpell(spell *Spell, query Query) bool {
+	if !matchesSearch(spell.Name, query) {
+		return false
+	}
+	if query.School != "" && !strings.EqualFold(spell.School, query.School) {
+		return false
+	}
+	if query.Level != nil && spell.Level != *query.Level {
+		return false
+	}
+	if query.Ritual != nil && spell.Ritual != *query.Ritual {
+		return false
+	}
+	if query.Concentration != nil && spell.Concentration != *query.Concentration {
+		return false
+	}
+	if query.Class != "" {
+		classID := c.classID(query.Class)
+		for _, class := range spell.Classes {
+			if class == classID {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// raceID resolves a race name or ID to its ID, falling back to the input
+func (c *Catalog) raceID(key string) string {
+	if race, ok := c.Race(key); ok {
+		return race.ID
+	}
+	return key
+}
+
+// classID resolves a class name or ID to its ID, falling back to the input
+func (c *Catalog) classID(key string) string {
+	if class, ok := c.Class(key); ok {
+		return class.ID
+	}
+	return key
+}
+
+func matchesKey(id, name, key string) bool {
+	key = strings.TrimSpace(key)
+	return strings.EqualFold(id, key) || strings.EqualFold(name, key)
+}
+
+func matchesSearch(name string, query Query) bool {
+	if query.Search == "" {
+		return true
+	}
+	return strings.Contains(strings.ToLower(name), strings.ToLower(query.Search))
+}
+
+func matchesRef(ref, filter string, resolve func(string) string) bool {
+	if filter == "" {
+		return true
+	}
+	return ref == resolve(filter)
+}
@@ -0,0 +1,169 @@
+package reference
+
+// Race is a playable race from the SRD. NaturalArmor is the base AC, before
+// the Dexterity modifier, of a race whose body protects it without armor.
+type Race struct {
+	ID                 string              `json:"id"`
+	Name               string              `json:"name"`
+	Size               string              `json:"size"`
+	Speed              int                 `json:"speed"`
+	AbilityBonuses     map[string]int      `json:"abilityBonuses,omitempty"`
+	AbilityBonusChoice *AbilityBonusChoice `json:"abilityBonusChoice,omitempty"`
+	Traits             []string            `json:"traits,omitempty"`
+	Resistances        []string            `json:"resistances,omitempty"`
+	Languages          []string            `json:"languages,omitempty"`
+	Subraces           []string            `json:"subraces,omitempty"`
+	NaturalArmor       int                 `json:"naturalArmor,omitempty"`
+}
+
+// AbilityBonusChoice describes ability score increases the player picks freely
+type AbilityBonusChoice struct {
+	Count   int      `json:"count"`
+	Amount  int      `json:"amount"`
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// Subrace is a variant of a race that grants additional traits, and natural
+// armor the same way as Race
+type Subrace struct {
+	ID             string         `json:"id"`
+	Name           string         `json:"name"`
+	Race           string         `json:"race"`
+	AbilityBonuses map[string]int `json:"abilityBonuses,omitempty"`
+	Traits         []string       `json:"traits,omitempty"`
+	NaturalArmor   int            `json:"naturalArmor,omitempty"`
+}
+
+// Class is a character class from the SRD. Features lists the features gained
+// at each class level, leaving out Ability Score Improvements and subclass
+// features.
+type Class struct {
+	ID                      string                 `json:"id"`
+	Name                    string                 `json:"name"`
+	HitDie                  int                    `json:"hitDie"`
+	PrimaryAbility          []string               `json:"primaryAbility"`
+	SavingThrows            []string               `json:"savingThrows"`
+	MulticlassPrerequisites MulticlassPrerequisite `json:"multiclassPrerequisites"`
+	Spellcasting            *ClassSpellcasting     `json:"spellcasting,omitempty"`
+	SubclassLevel           int                    `json:"subclassLevel"`
+	Subclasses              []string               `json:"subclasses,omitempty"`
+	Features                map[int][]string       `json:"features,omitempty"`
+	Resources               []ClassResource        `json:"resources,omitempty"`
+}
+
+// ClassResource is a limited-use class feature such as Rage or Ki, gained at
+// Level. Recharge is shortRest, longRest or dawn; from ShortRestLevel on the
+// resource recharges on a short rest instead.
+type ClassResource struct {
+	Name           string          `json:"name"`
+	Level          int             `json:"level"`
+	Recharge       string          `json:"recharge"`
+	ShortRestLevel int             `json:"shortRestLevel,omitempty"`
+	Maximum        ResourceMaximum `json:"maximum"`
+}
+
+// ResourceMaximum is the formula for a resource's uses: the Table entry for
+// the class level, plus PerLevel uses per class level, plus Bonus, plus the
+// Ability modifier, never less than Minimum. From UnlimitedLevel on uses are
+// unlimited.
+type ResourceMaximum struct {
+	Table          []int  `json:"table,omitempty"`
+	PerLevel       int    `json:"perLevel,omitempty"`
+	Bonus          int    `json:"bonus,omitempty"`
+	Ability        string `json:"ability,omitempty"`
+	Minimum        int    `json:"minimum,omitempty"`
+	UnlimitedLevel int    `json:"unlimitedLevel,omitempty"`
+}
+
+// MulticlassPrerequisite lists minimum ability scores needed to multiclass.
+// When AnyOf is set, meeting a single minimum is enough.
+type MulticlassPrerequisite struct {
+	Abilities map[string]int `json:"abilities"`
+	AnyOf     bool           `json:"anyOf"`
+}
+
+// ClassSpellcasting describes how a class casts spells. CantripsKnown and
+// SpellsKnown are indexed by class level minus one; SpellsKnown is empty for
+// classes that prepare spells, and is the minimum spellbook size for wizards.
+// Ritual names the spells a class can cast as rituals: those it knows, those
+// it has prepared, or any in its spellbook.
+type ClassSpellcasting struct {
+	Progression   string `json:"progression"`
+	Ability       string `json:"ability"`
+	Prepared      bool   `json:"prepared"`
+	Ritual        string `json:"ritual,omitempty"`
+	CantripsKnown []int  `json:"cantripsKnown,omitempty"`
+	SpellsKnown   []int  `json:"spellsKnown,omitempty"`
+}
+
+// Subclass is an archetype chosen within a class. NaturalArmor is the base AC
+// its features grant without armor and HitPointsPerLevel the extra hit points
+// per class level, both from Draconic Resilience.
+type Subclass struct {
+	ID                string             `json:"id"`
+	Name              string             `json:"name"`
+	Class             string             `json:"class"`
+	Level             int                `json:"level"`
+	Spellcasting      *ClassSpellcasting `json:"spellcasting,omitempty"`
+	Features          map[int][]string   `json:"features,omitempty"`
+	NaturalArmor      int                `json:"naturalArmor,omitempty"`
+	HitPointsPerLevel int                `json:"hitPointsPerLevel,omitempty"`
+}
+
+// Background is a character background from the SRD. AbilityScores lists the
+// abilities it can increase under the 2024 rules.
+type Background struct {
+	ID                 string   `json:"id"`
+	Name               string   `json:"name"`
+	SkillProficiencies []string `json:"skillProficiencies,omitempty"`
+	Languages          int      `json:"languages,omitempty"`
+	Equipment          []string `json:"equipment,omitempty"`
+	Feature            string   `json:"feature,omitempty"`
+	AbilityScores      []string `json:"abilityScores,omitempty"`
+}
+
+// Spell is a spell from the SRD
+type Spell struct {
+	ID            string   `json:"id"`
+	Name          string   `json:"name"`
+	Level         int      `json:"level"`
+	School        string   `json:"school"`
+	Classes       []string `json:"classes"`
+	Ritual        bool     `json:"ritual"`
+	Concentration bool     `json:"concentration"`
+}
+
+// Equipment is a weapon, armor or adventuring gear item from the SRD
+type Equipment struct {
+	ID                  string   `json:"id"`
+	Name                string   `json:"name"`
+	Category            string   `json:"category"`
+	Weight              float64  `json:"weight"`
+	Cost                Cost     `json:"cost"`
+	WeaponCategory      string   `json:"weaponCategory,omitempty"`
+	Damage              string   `json:"damage,omitempty"`
+	VersatileDamage     string   `json:"versatileDamage,omitempty"`
+	DamageType          string   `json:"damageType,omitempty"`
+	Properties          []string `json:"properties,omitempty"`
+	ArmorCategory       string   `json:"armorCategory,omitempty"`
+	BaseArmorClass      int      `json:"baseArmorClass,omitempty"`
+	DexBonus            bool     `json:"dexBonus,omitempty"`
+	MaxDexBonus         *int     `json:"maxDexBonus,omitempty"`
+	StrengthRequirement int      `json:"strengthRequirement,omitempty"`
+	StealthDisadvantage bool     `json:"stealthDisadvantage,omitempty"`
+	Capacity            float64  `json:"capacity,omitempty"`
+}
+
+// Cost is a price in a single coin denomination
+type Cost struct {
+	Quantity int    `json:"quantity"`
+	Unit     string `json:"unit"`
+}
+
+// Feat is a feat from the SRD
+type Feat struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Prerequisite string `json:"prerequisite,omitempty"`
+	Description  string `json:"description"`
+}
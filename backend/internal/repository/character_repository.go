@@ -2,10 +2,15 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	"github.com/yourusername/dnd-character-creator/internal/models"
 )
 
+// ErrVersionConflict is returned by Update when the stored character has
+// changed since it was read
+var ErrVersionConflict = errors.New("character version conflict")
+
 // CharacterRepository defines the interface for character data access
 type CharacterRepository interface {
 	// FindAll retrieves all characters with optional filtering
@@ -17,7 +22,8 @@ type CharacterRepository interface {
 	// Create creates a new character
 	Create(ctx context.Context, character *models.Character) error
 
-	// Update updates an existing character
+	// Update updates an existing character if its stored version still matches
+	// character.Version, then increments the version
 	Update(ctx context.Context, id string, character *models.Character) error
 
 	// Delete deletes a character by ID
@@ -25,6 +31,10 @@ type CharacterRepository interface {
 
 	// ExistsByName checks if a character with the given name exists
 	ExistsByName(ctx context.Context, name string, excludeID string) (bool, error)
+
+	// WithTransaction runs fn so that the writes it makes through ctx either
+	// all take effect or none do
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 // CharacterFilter holds filtering criteria for character queries
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/yourusername/dnd-character-creator/internal/models"
+)
+
+// ItemRepository defines the interface for homebrew item data access
+type ItemRepository interface {
+	// FindAll retrieves all homebrew items with optional filtering
+	FindAll(ctx context.Context, filter ItemFilter) ([]models.Item, error)
+
+	// FindByID retrieves a homebrew item by ID
+	FindByID(ctx context.Context, id string) (*models.Item, error)
+
+	// FindByIDs retrieves the homebrew items with the given IDs, skipping any
+	// that don't exist
+	FindByIDs(ctx context.Context, ids []string) ([]models.Item, error)
+
+	// Create creates a new homebrew item
+	Create(ctx context.Context, item *models.Item) error
+
+	// Update replaces an existing homebrew item
+	Update(ctx context.Context, id string, item *models.Item) error
+
+	// Delete deletes a homebrew item by ID
+	Delete(ctx context.Context, id string) error
+}
+
+// ItemFilter holds filtering criteria for homebrew item queries
+type ItemFilter struct {
+	Search   string
+	Category string
+}
@@ -101,6 +101,7 @@ func (r *characterRepository) FindByID(ctx context.Context, id string) (*models.
 func (r *characterRepository) Create(ctx context.Context, character *models.Character) error {
 	character.CreatedAt = time.Now()
 	character.UpdatedAt = time.Now()
+	character.Version = 1
 
 	result, err := r.collection.InsertOne(ctx, character)
 	if err != nil {
@@ -124,17 +125,41 @@ func (r *characterRepository) Update(ctx context.Context, id string, character *
 		return err
 	}
 
+	// Characters stored before versioning have no version field
+	filter := bson.M{"_id": objectID, "version": character.Version}
+	if character.Version == 0 {
+		filter["version"] = bson.M{"$in": bson.A{0, nil}}
+	}
+
+	version := character.Version
 	character.UpdatedAt = time.Now()
-	character.ID = id
+	character.Version++
 
-	update := bson.M{"$set": character}
-	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": objectID}, update)
+	// Replace the whole document so fields a change left empty, such as the
+	// last condition removed, are cleared instead of skipped by omitempty.
+	// The ID is left out of the replacement since _id can't change.
+	character.ID = ""
+	result, err := r.collection.ReplaceOne(ctx, filter, character)
+	character.ID = id
 	if err != nil {
+		character.Version = version
 		logger.GetLogger().WithError(err).Error("Failed to update character")
 		return err
 	}
 
 	if result.MatchedCount == 0 {
+		character.Version = version
+
+		count, err := r.collection.CountDocuments(ctx, bson.M{"_id": objectID})
+		if err != nil {
+			logger.GetLogger().WithError(err).Error("Failed to check character existence")
+			return err
+		}
+		if count > 0 {
+			logger.GetLogger().Warnf("Character %s was modified since version %d", id, version)
+			return repository.ErrVersionConflict
+		}
+
 		logger.GetLogger().Warn("No character found with given ID")
 		return mongo.ErrNoDocuments
 	}
@@ -183,3 +208,20 @@ func (r *characterRepository) ExistsByName(ctx context.Context, name string, exc
 
 	return count > 0, nil
 }
+
+// WithTransaction runs fn in a multi-document transaction, retrying it when
+// the transaction fails transiently. Transactions need MongoDB running as a
+// replica set; a single-node set is enough.
+func (r *characterRepository) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	session, err := r.collection.Database().Client().StartSession()
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to start session")
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessionCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessionCtx)
+	})
+	return err
+}
@@ -0,0 +1,165 @@
+package mongo
+
+import (
+	"context"
+
+	"github.com/yourusername/dnd-character-creator/internal/logger"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type itemRepository struct {
+	collection *mongo.Collection
+}
+
+// NewItemRepository creates a new MongoDB homebrew item repository
+func NewItemRepository(client *mongo.Client, database string) repository.ItemRepository {
+	collection := client.Database(database).Collection("items")
+	return &itemRepository{
+		collection: collection,
+	}
+}
+
+// FindAll retrieves all homebrew items with optional filtering
+func (r *itemRepository) FindAll(ctx context.Context, filter repository.ItemFilter) ([]models.Item, error) {
+	mongoFilter := bson.M{}
+
+	if filter.Search != "" {
+		mongoFilter["name"] = bson.M{"$regex": primitive.Regex{Pattern: filter.Search, Options: "i"}}
+	}
+
+	if filter.Category != "" {
+		mongoFilter["category"] = filter.Category
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+
+	return r.find(ctx, mongoFilter, opts)
+}
+
+// FindByID retrieves a homebrew item by ID
+func (r *itemRepository) FindByID(ctx context.Context, id string) (*models.Item, error) {
+	// No item can have an ID that is not an ObjectID
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		logger.GetLogger().WithError(err).Warn("Invalid item ID")
+		return nil, nil
+	}
+
+	var item models.Item
+	err = r.collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&item)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		logger.GetLogger().WithError(err).Error("Failed to find item by ID")
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+// FindByIDs retrieves the homebrew items with the given IDs. IDs that are not
+// valid object IDs can't be homebrew items and are ignored.
+func (r *itemRepository) FindByIDs(ctx context.Context, ids []string) ([]models.Item, error) {
+	objectIDs := bson.A{}
+	for _, id := range ids {
+		if objectID, err := primitive.ObjectIDFromHex(id); err == nil {
+			objectIDs = append(objectIDs, objectID)
+		}
+	}
+	if len(objectIDs) == 0 {
+		return []models.Item{}, nil
+	}
+
+	return r.find(ctx, bson.M{"_id": bson.M{"$in": objectIDs}})
+}
+
+// Create creates a new homebrew item
+func (r *itemRepository) Create(ctx context.Context, item *models.Item) error {
+	item.ID = ""
+
+	result, err := r.collection.InsertOne(ctx, item)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to create item")
+		return err
+	}
+
+	if oid, ok := result.InsertedID.(primitive.ObjectID); ok {
+		item.ID = oid.Hex()
+	}
+
+	return nil
+}
+
+// Update replaces an existing homebrew item
+func (r *itemRepository) Update(ctx context.Context, id string, item *models.Item) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		logger.GetLogger().WithError(err).Warn("Invalid item ID")
+		return mongo.ErrNoDocuments
+	}
+
+	item.ID = ""
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": objectID}, item)
+	item.ID = id
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to update item")
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		logger.GetLogger().Warn("No item found with given ID")
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+// Delete deletes a homebrew item by ID
+func (r *itemRepository) Delete(ctx context.Context, id string) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		logger.GetLogger().WithError(err).Warn("Invalid item ID")
+		return mongo.ErrNoDocuments
+	}
+
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to delete item")
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		logger.GetLogger().Warn("No item found with given ID")
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+// find decodes every item matching a filter
+func (r *itemRepository) find(ctx context.Context, filter bson.M, opts ...*options.FindOptions) ([]models.Item, error) {
+	cursor, err := r.collection.Find(ctx, filter, opts...)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to find items")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var items []models.Item
+	if err = cursor.All(ctx, &items); err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to decode items")
+		return nil, err
+	}
+
+	if items == nil {
+		items = []models.Item{}
+	}
+
+	return items, nil
+}
@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/yourusername/dnd-character-creator/internal/models"
+)
+
+// AbilityModifier calculates the modifier for an ability score as floor((score - 10) / 2)
+func AbilityModifier(score int) int {
+	diff := score - 10
+	if diff < 0 {
+		return (diff - 1) / 2
+	}
+	return diff / 2
+}
+
+// ProficiencyBonus returns the proficiency bonus for a total character level
+func ProficiencyBonus(level int) int {
+	if level < 1 {
+		level = 1
+	}
+	if level > 20 {
+		level = 20
+	}
+	return (level-1)/4 + 2
+}
+
+// applyAbilityModifiers sets the modifier for all ability scores
+func applyAbilityModifiers(scores *models.AbilityScores) {
+	scores.Strength.Modifier = AbilityModifier(scores.Strength.Score)
+	scores.Dexterity.Modifier = AbilityModifier(scores.Dexterity.Score)
+	scores.Constitution.Modifier = AbilityModifier(scores.Constitution.Score)
+	scores.Intelligence.Modifier = AbilityModifier(scores.Intelligence.Score)
+	scores.Wisdom.Modifier = AbilityModifier(scores.Wisdom.Score)
+	scores.Charisma.Modifier = AbilityModifier(scores.Charisma.Score)
+}
+
+// abilityModifierByName returns the modifier for an ability referenced by its
+// full or abbreviated name, case-insensitively
+func abilityModifierByName(scores *models.AbilityScores, ability string) (int, bool) {
+	score := AbilityByName(scores, ability)
+	if score == nil {
+		return 0, false
+	}
+	return score.Modifier, true
+}
+
+// AbilityByName returns the score for an ability referenced by its full or
+// abbreviated name, case-insensitively, or nil for an unknown ability
+func AbilityByName(scores *models.AbilityScores, ability string) *models.AbilityScore {
+	switch CanonicalAbility(ability) {
+	case "strength":
+		return &scores.Strength
+	case "dexterity":
+		return &scores.Dexterity
+	case "constitution":
+		return &scores.Constitution
+	case "intelligence":
+		return &scores.Intelligence
+	case "wisdom":
+		return &scores.Wisdom
+	case "charisma":
+		return &scores.Charisma
+	}
+	return nil
+}
+
+// CanonicalAbility returns the full lowercase name of an ability referenced by
+// its full or abbreviated name, or an empty string for an unknown ability
+func CanonicalAbility(ability string) string {
+	switch strings.ToLower(strings.TrimSpace(ability)) {
+	case "strength", "str":
+		return "strength"
+	case "dexterity", "dex":
+		return "dexterity"
+	case "constitution", "con":
+		return "constitution"
+	case "intelligence", "int":
+		return "intelligence"
+	case "wisdom", "wis":
+		return "wisdom"
+	case "charisma", "cha":
+		return "charisma"
+	}
+	return ""
+}
@@ -0,0 +1,141 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/yourusername/dnd-character-creator/internal/dice"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+)
+
+// Ability score generation methods
+const (
+	MethodPointBuy      = "pointBuy"
+	MethodStandardArray = "standardArray"
+	MethodRolled        = "rolled"
+	MethodManual        = "manual"
+)
+
+// Point buy limits from the Player's Handbook
+const (
+	PointBuyBudget  = 27
+	PointBuyMinimum = 8
+	PointBuyMaximum = 15
+)
+
+// pointBuyCosts is the total cost of buying each score up from 8
+var pointBuyCosts = map[int]int{8: 0, 9: 1, 10: 2, 11: 3, 12: 4, 13: 5, 14: 7, 15: 9}
+
+// StandardArray returns the six scores of the standard array
+func StandardArray() []int {
+	return []int{15, 14, 13, 12, 10, 8}
+}
+
+// PointBuyCosts returns the cost of every score that can be bought
+func PointBuyCosts() map[int]int {
+	costs := make(map[int]int, len(pointBuyCosts))
+	for score, cost := range pointBuyCosts {
+		costs[score] = cost
+	}
+	return costs
+}
+
+// PointBuyCost returns the points spent on a set of scores, or an error when
+// a score cannot be bought
+func PointBuyCost(scores []int) (int, error) {
+	total := 0
+	for _, score := range scores {
+		cost, ok := pointBuyCosts[score]
+		if !ok {
+			return 0, fmt.Errorf("score %d is outside the point buy range %d-%d", score, PointBuyMinimum, PointBuyMaximum)
+		}
+		total += cost
+	}
+	return total, nil
+}
+
+// RollAbilityScores rolls 4d6 and drops the lowest die six times. The same
+// seed always produces the same rolls, so a result can be verified later.
+func RollAbilityScores(seed uint64) []models.AbilityRoll {
+	roller := dice.NewRoller(seed)
+
+	rolls := make([]models.AbilityRoll, 6)
+	for i := range rolls {
+		values := make([]int, 4)
+		for j := range values {
+			values[j] = roller.Die(6)
+		}
+
+		lowest := slices.Min(values)
+		total := -lowest
+		for _, value := range values {
+			total += value
+		}
+
+		rolls[i] = models.AbilityRoll{Dice: values, Dropped: lowest, Total: total}
+	}
+	return rolls
+}
+
+// AbilityScoreValues lists the six scores in the order Strength, Dexterity,
+// Constitution, Intelligence, Wisdom, Charisma
+func AbilityScoreValues(scores *models.AbilityScores) []int {
+	return []int{
+		scores.Strength.Score,
+		scores.Dexterity.Score,
+		scores.Constitution.Score,
+		scores.Intelligence.Score,
+		scores.Wisdom.Score,
+		scores.Charisma.Score,
+	}
+}
+
+// ValidateAbilityScores checks that scores could have been produced by the
+// given method. Rolled scores are checked against the rolls for seed, in any
+// assignment to abilities; dice.VerifySeed checks that the seed itself was
+// issued.
+func ValidateAbilityScores(method string, scores []int, seed string) []string {
+	var errors []string
+
+	switch method {
+	case MethodPointBuy:
+		spent, err := PointBuyCost(scores)
+		if err != nil {
+			errors = append(errors, err.Error())
+		} else if spent > PointBuyBudget {
+			errors = append(errors, fmt.Sprintf("point buy spends %d points, more than the budget of %d", spent, PointBuyBudget))
+		}
+	case MethodStandardArray:
+		if !sameScores(scores, StandardArray()) {
+			errors = append(errors, "scores must use each value of the standard array 15, 14, 13, 12, 10, 8 exactly once")
+		}
+	case MethodRolled:
+		value, err := dice.ParseSeed(seed)
+		if err != nil {
+			errors = append(errors, err.Error())
+			break
+		}
+		var totals []int
+		for _, roll := range RollAbilityScores(value) {
+			totals = append(totals, roll.Total)
+		}
+		if !sameScores(scores, totals) {
+			errors = append(errors, fmt.Sprintf("scores do not match the rolls %v for seed %s", totals, seed))
+		}
+	case MethodManual:
+		// Manually entered scores are not constrained
+	default:
+		errors = append(errors, fmt.Sprintf("unknown ability score method %q", method))
+	}
+
+	return errors
+}
+
+// sameScores reports whether two sets of scores hold the same values in any
+// order
+func sameScores(a, b []int) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
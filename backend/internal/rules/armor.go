@@ -0,0 +1,209 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/reference"
+)
+
+// Armor categories
+const (
+	ArmorLight  = "light"
+	ArmorMedium = "medium"
+	ArmorHeavy  = "heavy"
+	ArmorShield = "shield"
+)
+
+// mediumArmorMaxDex is the most Dexterity a medium armor wearer adds to AC
+const mediumArmorMaxDex = 2
+
+// ArmorCategory classifies an armor item from its type, such as "Heavy Armor"
+// or "Shield", falling back to the catalog entry with the same name
+func ArmorCategory(catalog *reference.Catalog, item *models.ArmorItem) string {
+	itemType := strings.ToLower(item.Type)
+	switch {
+	case strings.Contains(itemType, ArmorShield):
+		return ArmorShield
+	case strings.Contains(itemType, ArmorHeavy):
+		return ArmorHeavy
+	case strings.Contains(itemType, ArmorMedium):
+		return ArmorMedium
+	case strings.Contains(itemType, ArmorLight):
+		return ArmorLight
+	}
+
+	if catalog != nil {
+		if entry, ok := catalog.Equipment(item.Name); ok && entry.ArmorCategory != "" {
+			return entry.ArmorCategory
+		}
+	}
+
+	return ""
+}
+
+// EquippedArmor splits the equipped armor in an inventory into body armor and
+// shields. Armor held as catalog items is described by its catalog stats.
+func EquippedArmor(catalog *reference.Catalog, inventory *models.Inventory) (body []*models.ArmorItem, shields []*models.ArmorItem) {
+	if inventory == nil {
+		return nil, nil
+	}
+
+	armor := make([]*models.ArmorItem, 0, len(inventory.Armor))
+	for i := range inventory.Armor {
+		armor = append(armor, &inventory.Armor[i])
+	}
+	for i := range inventory.Items {
+		item := &inventory.Items[i]
+		stats := ItemStats(catalog, item)
+		if stats == nil || stats.ArmorCategory == "" {
+			continue
+		}
+		armor = append(armor, &models.ArmorItem{
+			Name:                ItemName(item),
+			Type:                stats.ArmorCategory,
+			ArmorClass:          stats.BaseArmorClass,
+			Equipped:            item.Equipped,
+			StealthDisadvantage: stats.StealthDisadvantage,
+			StrengthRequirement: stats.StrengthRequirement,
+			Weight:              stats.Weight,
+		})
+	}
+
+	for _, item := range armor {
+		if !item.Equipped {
+			continue
+		}
+		if ArmorCategory(catalog, item) == ArmorShield {
+			shields = append(shields, item)
+		} else {
+			body = append(body, item)
+		}
+	}
+
+	return body, shields
+}
+
+// applyArmorClass computes Armor Class from equipped armor, shields and
+// unarmored features, recording where each point came from
+func (e *Engine) applyArmorClass(character *models.Character) {
+	scores := &character.AbilityScores
+	body, shields := EquippedArmor(e.catalog, character.Inventory)
+
+	var components []models.ArmorClassComponent
+	if len(body) > 0 {
+		armor := body[0]
+		components = append(components, models.ArmorClassComponent{Source: armor.Name, Value: e.armorValue(armor)})
+
+		switch ArmorCategory(e.catalog, armor) {
+		case ArmorLight:
+			components = appendComponent(components, "Dexterity", scores.Dexterity.Modifier)
+		case ArmorMedium:
+			components = appendComponent(components, "Dexterity (max 2)", min(scores.Dexterity.Modifier, mediumArmorMaxDex))
+		}
+	} else {
+		components = e.unarmoredArmorClass(character, len(shields) > 0)
+	}
+
+	if len(shields) > 0 {
+		components = append(components, models.ArmorClassComponent{Source: shields[0].Name, Value: e.armorValue(shields[0])})
+	}
+
+	character.ArmorClass = sumComponents(components)
+	character.ArmorClassBreakdown = components
+}
+
+// unarmoredArmorClass returns the best AC available without body armor from
+// the base 10, Unarmored Defense, Mage Armor and natural armor
+func (e *Engine) unarmoredArmorClass(character *models.Character, shield bool) []models.ArmorClassComponent {
+	scores := &character.AbilityScores
+	dex := scores.Dexterity.Modifier
+
+	options := [][]models.ArmorClassComponent{
+		appendComponent([]models.ArmorClassComponent{{Source: "Unarmored", Value: 10}}, "Dexterity", dex),
+	}
+
+	if e.classLevel(character, "barbarian") > 0 {
+		option := appendComponent([]models.ArmorClassComponent{{Source: "Unarmored", Value: 10}}, "Dexterity", dex)
+		options = append(options, appendComponent(option, "Constitution (Unarmored Defense)", scores.Constitution.Modifier))
+	}
+
+	if e.classLevel(character, "monk") > 0 && !shield {
+		option := appendComponent([]models.ArmorClassComponent{{Source: "Unarmored", Value: 10}}, "Dexterity", dex)
+		options = append(options, appendComponent(option, "Wisdom (Unarmored Defense)", scores.Wisdom.Modifier))
+	}
+
+	if effects := character.ArmorClassEffects; effects != nil && effects.MageArmor {
+		options = append(options, appendComponent([]models.ArmorClassComponent{{Source: "Mage Armor", Value: 13}}, "Dexterity", dex))
+	}
+
+	if natural := e.NaturalArmor(character); natural > 0 {
+		options = append(options, appendComponent([]models.ArmorClassComponent{{Source: "Natural Armor", Value: natural}}, "Dexterity", dex))
+	}
+
+	best := options[0]
+	for _, option := range options[1:] {
+		if sumComponents(option) > sumComponents(best) {
+			best = option
+		}
+	}
+
+	return best
+}
+
+// NaturalArmor returns the best natural armor base AC the character has from
+// its race, subrace or subclasses in the catalog, or from a client-supplied
+// effect. The validator only accepts client values in lenient mode.
+func (e *Engine) NaturalArmor(character *models.Character) int {
+	natural := 0
+	if effects := character.ArmorClassEffects; effects != nil {
+		natural = effects.NaturalArmor
+	}
+	if e.catalog == nil {
+		return natural
+	}
+
+	if race, ok := e.catalog.Race(character.Race); ok {
+		natural = max(natural, race.NaturalArmor)
+	}
+	if subrace, ok := e.catalog.Subrace(character.Subrace); ok {
+		natural = max(natural, subrace.NaturalArmor)
+	}
+	for _, sl := range e.subclassLevels(character) {
+		natural = max(natural, sl.subclass.NaturalArmor)
+	}
+	return natural
+}
+
+// armorValue returns the AC an armor item provides, using the catalog value
+// when the item does not specify one. Shields default to +2.
+func (e *Engine) armorValue(item *models.ArmorItem) int {
+	if item.ArmorClass > 0 {
+		return item.ArmorClass
+	}
+	if e.catalog != nil {
+		if entry, ok := e.catalog.Equipment(item.Name); ok && entry.BaseArmorClass > 0 {
+			return entry.BaseArmorClass
+		}
+	}
+	if ArmorCategory(e.catalog, item) == ArmorShield {
+		return 2
+	}
+	return 10
+}
+
+// appendComponent adds a component unless it contributes nothing
+func appendComponent(components []models.ArmorClassComponent, source string, value int) []models.ArmorClassComponent {
+	if value == 0 {
+		return components
+	}
+	return append(components, models.ArmorClassComponent{Source: source, Value: value})
+}
+
+func sumComponents(components []models.ArmorClassComponent) int {
+	total := 0
+	for _, component := range components {
+		total += component.Value
+	}
+	return total
+}
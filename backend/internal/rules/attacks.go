@@ -0,0 +1,67 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/yourusername/dnd-character-creator/internal/dice"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+)
+
+// RollAttack resolves the attack at index on the character sheet against the
+// target's armor class. A natural 20 always hits and doubles the damage dice;
+// a natural 1 always misses. Damage is only rolled on a hit.
+func (e *Engine) RollAttack(character *models.Character, index int, request *models.AttackRollRequest, roll func(int) int) (*models.AttackRollResult, error) {
+	// Attack bonuses and damage are derived, so the entry is read after the
+	// character is recalculated
+	e.Apply(character)
+	if index < 0 || index >= len(character.Attacks) {
+		return nil, fmt.Errorf("character has no attack at index %d", index)
+	}
+	attack := character.Attacks[index]
+
+	if character.Status != StatusConscious {
+		return nil, fmt.Errorf("character is %s and cannot attack", character.Status)
+	}
+	if character.ConditionEffects != nil && character.ConditionEffects.Incapacitated {
+		return nil, errors.New("character is incapacitated and cannot attack")
+	}
+
+	var mode rollMode
+	mode.add(request.Mode)
+	if character.ConditionEffects != nil {
+		mode.add(character.ConditionEffects.AttackRolls)
+	}
+
+	result := &models.AttackRollResult{
+		Attack:     attack.Name,
+		Mode:       mode.String(),
+		TargetAC:   request.TargetAC,
+		DamageType: attack.DamageType,
+	}
+
+	attackRoll, err := dice.Roll(roll, fmt.Sprintf("1d20%+d", attack.AttackBonus), result.Mode)
+	if err != nil {
+		return nil, err
+	}
+	result.AttackRoll = attackRoll
+	result.Natural = attackRoll.Terms[0].Total
+	result.Total = attackRoll.Total
+	result.Critical = result.Natural == 20
+	result.Hit = result.Critical || (result.Natural != 1 && result.Total >= request.TargetAC)
+
+	if !result.Hit || attack.Damage == "" {
+		return result, nil
+	}
+
+	damage, err := dice.Parse(attack.Damage)
+	if err != nil {
+		return nil, fmt.Errorf("attack %q has damage %q that cannot be rolled: %w", attack.Name, attack.Damage, err)
+	}
+	if result.Critical {
+		damage = damage.Critical()
+	}
+	result.Damage = damage.Roll(roll)
+
+	return result, nil
+}
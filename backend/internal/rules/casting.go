@@ -0,0 +1,204 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/reference"
+)
+
+// Kinds of slot a spell can be cast with
+const (
+	SlotSpell     = "spellSlot"
+	SlotPactMagic = "pactMagic"
+)
+
+// Which spells a class can cast as rituals
+const (
+	RitualKnown     = "known"
+	RitualPrepared  = "prepared"
+	RitualSpellbook = "spellbook"
+)
+
+// Cast casts a spell the character knows or has prepared. Levelled spells
+// consume a spell slot of at least the spell's level, or a Pact Magic slot,
+// unless cast as a ritual by a class that allows it. Casting a concentration
+// spell ends any spell the character was already concentrating on.
+func (e *Engine) Cast(character *models.Character, request *models.CastRequest) (*models.CastResult, error) {
+	e.Apply(character)
+
+	if character.Status != StatusConscious {
+		return nil, fmt.Errorf("character is %s and cannot cast spells", character.Status)
+	}
+	sc := character.Spellcasting
+	if sc == nil {
+		return nil, errors.New("character has no spellcasting")
+	}
+
+	result := &models.CastResult{Character: character, Spell: request.Spell}
+	ritual := false
+	if spell, ok := e.catalogSpell(request.Spell); ok {
+		result.Spell = spell.Name
+		result.SpellLevel = spell.Level
+		result.Concentration = spell.Concentration
+		ritual = spell.Ritual
+	} else if !containsFold(sc.CantripsKnown, request.Spell) {
+		// Spells missing from the catalog are cast at the requested level
+		if request.SlotLevel == 0 {
+			return nil, fmt.Errorf("%s is not in the reference catalog, slotLevel is required", request.Spell)
+		}
+		result.SpellLevel = request.SlotLevel
+	}
+
+	switch {
+	case request.Ritual:
+		if !ritual {
+			return nil, fmt.Errorf("%s is not a ritual", result.Spell)
+		}
+		if !e.canCastRitual(character, result.Spell) {
+			return nil, fmt.Errorf("none of the character's classes can cast %s as a ritual", result.Spell)
+		}
+		result.Ritual = true
+
+	case result.SpellLevel == 0:
+		if !containsFold(sc.CantripsKnown, result.Spell) {
+			return nil, fmt.Errorf("%s is not a known cantrip", result.Spell)
+		}
+
+	default:
+		if !e.canCast(character, result.Spell) {
+			return nil, fmt.Errorf("%s is not prepared or known", result.Spell)
+		}
+		if err := spendSlot(sc, request, result); err != nil {
+			return nil, err
+		}
+	}
+
+	if result.Concentration {
+		if sc.Concentration != nil {
+			result.EndedConcentration = sc.Concentration.Spell
+		}
+		sc.Concentration = &models.Concentration{
+			Spell:     result.Spell,
+			SlotLevel: max(result.SlotLevel, result.SpellLevel),
+		}
+	}
+
+	return result, nil
+}
+
+// spendSlot consumes the slot a levelled spell is cast with. Characters with
+// only Pact Magic always use it.
+func spendSlot(sc *models.Spellcasting, request *models.CastRequest, result *models.CastResult) error {
+	slotLevel := request.SlotLevel
+	if slotLevel == 0 {
+		slotLevel = result.SpellLevel
+	}
+
+	if request.PactMagic || (sc.SpellSlots == nil && sc.PactMagic != nil) {
+		pact := sc.PactMagic
+		if pact == nil || pact.Total == 0 {
+			return errors.New("character has no Pact Magic slots")
+		}
+		if request.SlotLevel != 0 && request.SlotLevel != pact.SlotLevel {
+			return fmt.Errorf("the character's Pact Magic slots are level %d", pact.SlotLevel)
+		}
+		if pact.SlotLevel < result.SpellLevel {
+			return fmt.Errorf("%s is a level %d spell and cannot be cast with a level %d slot", result.Spell, result.SpellLevel, pact.SlotLevel)
+		}
+		if pact.Used >= pact.Total {
+			return errors.New("no Pact Magic slots remaining")
+		}
+
+		pact.Used++
+		result.Slot = SlotPactMagic
+		result.SlotLevel = pact.SlotLevel
+		result.SlotsRemaining = pact.Total - pact.Used
+		result.Upcast = pact.SlotLevel > result.SpellLevel
+		return nil
+	}
+
+	if sc.SpellSlots == nil {
+		return errors.New("character has no spell slots")
+	}
+	if slotLevel < result.SpellLevel {
+		return fmt.Errorf("%s is a level %d spell and cannot be cast with a level %d slot", result.Spell, result.SpellLevel, slotLevel)
+	}
+	slot := SpellSlotLevels(sc.SpellSlots)[slotLevel-1]
+	if slot.Used >= slot.Total {
+		return fmt.Errorf("no level %d spell slots remaining", slotLevel)
+	}
+
+	slot.Used++
+	result.Slot = SlotSpell
+	result.SlotLevel = slotLevel
+	result.SlotsRemaining = slot.Total - slot.Used
+	result.Upcast = slotLevel > result.SpellLevel
+	return nil
+}
+
+// canCast reports whether the character can cast a levelled spell. Prepared
+// spells always can; known spells can unless every one of the character's
+// spellcasting classes prepares its spells, in which case SpellsKnown is a
+// spellbook.
+func (e *Engine) canCast(character *models.Character, spell string) bool {
+	sc := character.Spellcasting
+	if containsFold(sc.PreparedSpells, spell) {
+		return true
+	}
+	if !containsFold(sc.SpellsKnown, spell) {
+		return false
+	}
+
+	classes := e.spellcastingClasses(character)
+	for _, class := range classes {
+		if !class.Spellcasting.Prepared {
+			return true
+		}
+	}
+	return len(classes) == 0
+}
+
+// canCastRitual reports whether one of the character's classes has the spell
+// on its list and can cast it as a ritual
+func (e *Engine) canCastRitual(character *models.Character, name string) bool {
+	spell, ok := e.catalogSpell(name)
+	if !ok {
+		return false
+	}
+
+	sc := character.Spellcasting
+	for _, class := range e.spellcastingClasses(character) {
+		if !slices.Contains(spell.Classes, class.ID) {
+			continue
+		}
+		switch class.Spellcasting.Ritual {
+		case RitualKnown, RitualSpellbook:
+			if containsFold(sc.SpellsKnown, spell.Name) {
+				return true
+			}
+		case RitualPrepared:
+			if containsFold(sc.PreparedSpells, spell.Name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// catalogSpell looks up a spell in the reference catalog, if there is one
+func (e *Engine) catalogSpell(name string) (*reference.Spell, bool) {
+	if e.catalog == nil {
+		return nil, false
+	}
+	return e.catalog.Spell(name)
+}
+
+// endConcentration stops the character concentrating on a spell
+func endConcentration(character *models.Character) {
+	if character.Spellcasting != nil {
+		character.Spellcasting.Concentration = nil
+	}
+}
@@ -0,0 +1,152 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/reference"
+)
+
+// ClassLevel is the number of levels a character has in a single class
+type ClassLevel struct {
+	Class    string
+	Subclass string
+	Level    int
+}
+
+// PrimaryClassLevel returns the character's level in its primary class.
+// When ClassLevel is not set it is whatever remains of the total level after
+// the multiclass entries.
+func PrimaryClassLevel(character *models.Character) int {
+	if character.ClassLevel > 0 {
+		return character.ClassLevel
+	}
+	return character.Level - MulticlassLevels(character)
+}
+
+// MulticlassLevels returns the sum of all multiclass entry levels
+func MulticlassLevels(character *models.Character) int {
+	total := 0
+	for _, mc := range character.Multiclass {
+		total += mc.Level
+	}
+	return total
+}
+
+// ClassLevels lists the primary class followed by each multiclass entry
+func ClassLevels(character *models.Character) []ClassLevel {
+	levels := []ClassLevel{{
+		Class:    character.Class,
+		Subclass: character.Subclass,
+		Level:    PrimaryClassLevel(character),
+	}}
+
+	for _, mc := range character.Multiclass {
+		levels = append(levels, ClassLevel{
+			Class:    mc.Class,
+			Subclass: mc.Subclass,
+			Level:    mc.Level,
+		})
+	}
+
+	return levels
+}
+
+// classLevel returns the character's total levels in the class with the given
+// catalog ID
+func (e *Engine) classLevel(character *models.Character, classID string) int {
+	total := 0
+	for _, cl := range ClassLevels(character) {
+		if e.classID(cl.Class) == classID {
+			total += cl.Level
+		}
+	}
+	return total
+}
+
+// subclassLevel is a catalog subclass the character has reached along with
+// its levels in the subclass's class
+type subclassLevel struct {
+	subclass *reference.Subclass
+	level    int
+}
+
+// subclassLevels returns the catalog subclasses the character has chosen and
+// reached the level for
+func (e *Engine) subclassLevels(character *models.Character) []subclassLevel {
+	if e.catalog == nil {
+		return nil
+	}
+
+	var levels []subclassLevel
+	for _, cl := range ClassLevels(character) {
+		if subclass, ok := e.catalog.Subclass(cl.Subclass); ok && cl.Level >= subclass.Level {
+			levels = append(levels, subclassLevel{subclass: subclass, level: cl.Level})
+		}
+	}
+	return levels
+}
+
+// spellcastingClasses returns the catalog entries for the character's classes
+// that cast spells
+func (e *Engine) spellcastingClasses(character *models.Character) []*reference.Class {
+	if e.catalog == nil {
+		return nil
+	}
+
+	var classes []*reference.Class
+	for _, cl := range ClassLevels(character) {
+		if class, ok := e.catalog.Class(cl.Class); ok && class.Spellcasting != nil {
+			classes = append(classes, class)
+		}
+	}
+	return classes
+}
+
+// classID resolves a class name to its catalog ID, falling back to the
+// lowercased name for classes missing from the catalog
+func (e *Engine) classID(name string) string {
+	if e.catalog != nil {
+		if class, ok := e.catalog.Class(name); ok {
+			return class.ID
+		}
+	}
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// MeetsMulticlassPrerequisites reports whether ability scores meet a class's
+// minimums for multiclassing into or out of it
+func MeetsMulticlassPrerequisites(scores *models.AbilityScores, prereq reference.MulticlassPrerequisite) bool {
+	if len(prereq.Abilities) == 0 {
+		return true
+	}
+
+	met := 0
+	for ability, minimum := range prereq.Abilities {
+		if score := AbilityByName(scores, ability); score != nil && score.Score >= minimum {
+			met++
+		}
+	}
+
+	if prereq.AnyOf {
+		return met > 0
+	}
+	return met == len(prereq.Abilities)
+}
+
+// DescribeMulticlassPrerequisites renders prerequisites such as "strength 13
+// and charisma 13" in a stable ability order
+func DescribeMulticlassPrerequisites(prereq reference.MulticlassPrerequisite) string {
+	var parts []string
+	for _, ability := range Abilities {
+		if minimum, ok := prereq.Abilities[ability]; ok {
+			parts = append(parts, fmt.Sprintf("%s %d", ability, minimum))
+		}
+	}
+
+	if prereq.AnyOf {
+		return strings.Join(parts, " or ")
+	}
+	return strings.Join(parts, " and ")
+}
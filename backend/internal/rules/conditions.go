@@ -0,0 +1,267 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/yourusername/dnd-character-creator/internal/models"
+)
+
+// Exhaustion is tracked in levels rather than as a condition entry
+const (
+	ConditionExhaustion  = "exhaustion"
+	ConditionUnconscious = "unconscious"
+	MaxExhaustion        = 6
+)
+
+// Roll modes set by conditions
+const (
+	RollAdvantage    = "advantage"
+	RollDisadvantage = "disadvantage"
+)
+
+// conditionEffect is how a condition from the Player's Handbook appendix
+// changes the character's rolls and movement
+type conditionEffect struct {
+	attackRolls   string
+	abilityChecks string
+	speedZero     bool
+	incapacitated bool
+	autoFailSaves bool
+	notes         []string
+}
+
+// conditions maps each condition to its mechanical effects
+var conditions = map[string]conditionEffect{
+	"blinded": {
+		attackRolls: RollDisadvantage,
+		notes:       []string{"automatically fails ability checks that require sight"},
+	},
+	"charmed": {
+		notes: []string{"can't attack the charmer"},
+	},
+	"deafened": {
+		notes: []string{"automatically fails ability checks that require hearing"},
+	},
+	"frightened": {
+		attackRolls:   RollDisadvantage,
+		abilityChecks: RollDisadvantage,
+		notes:         []string{"can't willingly move closer to the source of its fear"},
+	},
+	"grappled": {
+		speedZero: true,
+	},
+	"incapacitated": {
+		incapacitated: true,
+	},
+	"invisible": {
+		attackRolls: RollAdvantage,
+	},
+	"paralyzed": {
+		speedZero:     true,
+		incapacitated: true,
+		autoFailSaves: true,
+	},
+	"petrified": {
+		speedZero:     true,
+		incapacitated: true,
+		autoFailSaves: true,
+		notes:         []string{"resistance to all damage"},
+	},
+	"poisoned": {
+		attackRolls:   RollDisadvantage,
+		abilityChecks: RollDisadvantage,
+	},
+	"prone": {
+		attackRolls: RollDisadvantage,
+	},
+	"restrained": {
+		attackRolls: RollDisadvantage,
+		speedZero:   true,
+		notes:       []string{"disadvantage on Dexterity saving throws"},
+	},
+	"stunned": {
+		speedZero:     true,
+		incapacitated: true,
+		autoFailSaves: true,
+	},
+	ConditionUnconscious: {
+		speedZero:     true,
+		incapacitated: true,
+		autoFailSaves: true,
+		notes:         []string{"falls prone and drops whatever it is holding"},
+	},
+}
+
+// IsCondition reports whether name is a condition from the Player's Handbook,
+// including exhaustion
+func IsCondition(name string) bool {
+	name = strings.ToLower(strings.TrimSpace(name))
+	_, ok := conditions[name]
+	return ok || name == ConditionExhaustion
+}
+
+// AddCondition applies a condition to the character. A condition the
+// character already has takes the new source and duration. Exhaustion adds
+// levels instead, up to six.
+func (e *Engine) AddCondition(character *models.Character, request *models.ConditionRequest) error {
+	name := strings.ToLower(strings.TrimSpace(request.Name))
+	if !IsCondition(name) {
+		return fmt.Errorf("unknown condition %q", request.Name)
+	}
+
+	if name == ConditionExhaustion {
+		character.Exhaustion = min(character.Exhaustion+max(request.Levels, 1), MaxExhaustion)
+		e.Apply(character)
+		return nil
+	}
+
+	condition := models.Condition{Name: name, Source: request.Source, Duration: request.Duration}
+	if i := conditionIndex(character, name); i >= 0 {
+		character.Conditions[i] = condition
+	} else {
+		character.Conditions = append(character.Conditions, condition)
+	}
+
+	e.Apply(character)
+	return nil
+}
+
+// RemoveCondition ends a condition. Removing exhaustion lowers it by one
+// level.
+func (e *Engine) RemoveCondition(character *models.Character, name string) error {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if !IsCondition(name) {
+		return fmt.Errorf("unknown condition %q", name)
+	}
+
+	if name == ConditionExhaustion {
+		if character.Exhaustion == 0 {
+			return errors.New("character is not exhausted")
+		}
+		character.Exhaustion--
+		e.Apply(character)
+		return nil
+	}
+
+	i := conditionIndex(character, name)
+	if i < 0 {
+		return fmt.Errorf("character is not %s", name)
+	}
+	character.Conditions = slices.Delete(character.Conditions, i, i+1)
+
+	e.Apply(character)
+	return nil
+}
+
+// conditionIndex returns the position of a condition in the character's
+// list, or -1
+func conditionIndex(character *models.Character, name string) int {
+	return slices.IndexFunc(character.Conditions, func(c models.Condition) bool {
+		return strings.EqualFold(c.Name, name)
+	})
+}
+
+// applyConditions derives roll modes and speed from the character's
+// conditions and exhaustion level. A dying or stable character is
+// unconscious. Advantage and disadvantage on the same roll cancel out.
+func applyConditions(character *models.Character) {
+	active := make([]string, 0, len(character.Conditions)+1)
+	for _, condition := range character.Conditions {
+		active = append(active, strings.ToLower(condition.Name))
+	}
+	if character.Status == StatusDying || character.Status == StatusStable {
+		if !slices.Contains(active, ConditionUnconscious) {
+			active = append(active, ConditionUnconscious)
+		}
+	}
+
+	if len(active) == 0 && character.Exhaustion == 0 {
+		character.ConditionEffects = nil
+		return
+	}
+
+	var attacks, checks, saves rollMode
+	effects := &models.ConditionEffects{}
+	speedZero := false
+	halveSpeed := false
+
+	for _, name := range active {
+		effect, ok := conditions[name]
+		if !ok {
+			continue
+		}
+		attacks.add(effect.attackRolls)
+		checks.add(effect.abilityChecks)
+		speedZero = speedZero || effect.speedZero
+		effects.Incapacitated = effects.Incapacitated || effect.incapacitated
+		if effect.autoFailSaves {
+			effects.AutoFailSaves = []string{"strength", "dexterity"}
+		}
+		for _, note := range effect.notes {
+			effects.Notes = append(effects.Notes, name+": "+note)
+		}
+	}
+
+	// Exhaustion levels are cumulative
+	exhaustion := character.Exhaustion
+	if exhaustion >= 1 {
+		checks.add(RollDisadvantage)
+	}
+	if exhaustion >= 2 {
+		halveSpeed = true
+	}
+	if exhaustion >= 3 {
+		attacks.add(RollDisadvantage)
+		saves.add(RollDisadvantage)
+	}
+	if exhaustion >= 5 {
+		speedZero = true
+	}
+
+	effects.AttackRolls = attacks.String()
+	effects.AbilityChecks = checks.String()
+	effects.SavingThrows = saves.String()
+	character.ConditionEffects = effects
+
+	if character.EffectiveSpeed == nil {
+		return
+	}
+	speed := character.EffectiveSpeed
+	for _, value := range []*int{&speed.Walk, &speed.Fly, &speed.Swim, &speed.Climb, &speed.Burrow} {
+		switch {
+		case speedZero:
+			*value = 0
+		case halveSpeed:
+			*value /= 2
+		}
+	}
+}
+
+// rollMode collects advantage and disadvantage on a kind of roll
+type rollMode struct {
+	advantage    bool
+	disadvantage bool
+}
+
+func (m *rollMode) add(mode string) {
+	switch mode {
+	case RollAdvantage:
+		m.advantage = true
+	case RollDisadvantage:
+		m.disadvantage = true
+	}
+}
+
+// String returns the resulting roll mode, empty for a normal roll
+func (m rollMode) String() string {
+	switch {
+	case m.advantage && !m.disadvantage:
+		return RollAdvantage
+	case m.disadvantage && !m.advantage:
+		return RollDisadvantage
+	}
+	return ""
+}
@@ -0,0 +1,239 @@
+package rules
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/reference"
+)
+
+// itemContainer returns how much an item can hold, or nil for items that
+// aren't containers
+func itemContainer(stats *models.Item) *models.ItemContainer {
+	if stats == nil {
+		return nil
+	}
+	return stats.Container
+}
+
+// MoveItem packs an inventory item into a container, or takes it out of its
+// container when the request names none. Moving part of a stack splits it.
+// Packed items are unequipped. It fails when the move would break a packing
+// rule the character didn't already break, such as overfilling a container.
+func (e *Engine) MoveItem(character *models.Character, id string, request *models.MoveItemRequest) error {
+	e.Apply(character)
+
+	item := findInventoryItem(character, id)
+	if item == nil {
+		return fmt.Errorf("character has no item %q", id)
+	}
+	name := ItemName(item)
+
+	if request.ContainerID != "" {
+		container := findInventoryItem(character, request.ContainerID)
+		if container == nil {
+			return fmt.Errorf("character has no item %q", request.ContainerID)
+		}
+		if itemContainer(container.Item) == nil {
+			return fmt.Errorf("%s is not a container", ItemName(container))
+		}
+	}
+	if item.ContainerID == request.ContainerID {
+		if request.ContainerID == "" {
+			return fmt.Errorf("%s is not in a container", name)
+		}
+		return fmt.Errorf("%s is already in that container", name)
+	}
+
+	available := max(item.Quantity, 1)
+	quantity := request.Quantity
+	if quantity == 0 {
+		quantity = available
+	}
+	if quantity > available {
+		return fmt.Errorf("only %d of %s to move", available, name)
+	}
+
+	before := ContainerErrors(e.catalog, character.Inventory)
+	previous := slices.Clone(character.Inventory.Items)
+
+	moved := item
+	if quantity < available {
+		split := *item
+		split.ID = NewItemID()
+		split.Quantity = quantity
+		split.Attuned = false
+		if item.Charges != nil {
+			charges := *item.Charges
+			split.Charges = &charges
+		}
+		item.Quantity -= quantity
+		character.Inventory.Items = append(character.Inventory.Items, split)
+		moved = &character.Inventory.Items[len(character.Inventory.Items)-1]
+	}
+	moved.ContainerID = request.ContainerID
+	if moved.ContainerID != "" {
+		moved.Equipped = false
+	}
+
+	var problems []string
+	for _, problem := range ContainerErrors(e.catalog, character.Inventory) {
+		if !slices.Contains(before, problem) {
+			problems = append(problems, problem)
+		}
+	}
+	if len(problems) > 0 {
+		character.Inventory.Items = previous
+		return fmt.Errorf("cannot move %s: %s", name, strings.Join(problems, "; "))
+	}
+
+	e.Apply(character)
+	return nil
+}
+
+// ContainerErrors checks how items are packed: every item must be packed in a
+// container the character holds, no item can be packed inside itself, an
+// equipped item can't be packed and no container can hold more than its
+// capacity
+func ContainerErrors(catalog *reference.Catalog, inventory *models.Inventory) []string {
+	if inventory == nil {
+		return nil
+	}
+
+	var errors []string
+	for i := range inventory.Items {
+		item := &inventory.Items[i]
+		if item.ContainerID == "" {
+			continue
+		}
+		name := catalogItemName(catalog, item)
+
+		container := containerOf(inventory, item)
+		switch {
+		case container == nil:
+			errors = append(errors, fmt.Sprintf("%s is packed in %q, which the character doesn't have", name, item.ContainerID))
+		case itemContainer(ItemStats(catalog, container)) == nil:
+			errors = append(errors, fmt.Sprintf("%s is packed in %s, which is not a container", name, catalogItemName(catalog, container)))
+		case packedInside(inventory, container, item):
+			errors = append(errors, fmt.Sprintf("%s cannot be packed inside itself", name))
+		case item.Equipped:
+			errors = append(errors, fmt.Sprintf("%s is equipped and cannot be packed in %s", name, catalogItemName(catalog, container)))
+		}
+	}
+
+	for i := range inventory.Items {
+		container := &inventory.Items[i]
+		stats := itemContainer(ItemStats(catalog, container))
+		// A packing loop is already an error and has no meaningful weight
+		if stats == nil || packedInside(inventory, containerOf(inventory, container), container) {
+			continue
+		}
+		capacity := stats.Capacity * float64(max(container.Quantity, 1))
+		if held := contentsWeight(catalog, inventory, container); held > capacity {
+			errors = append(errors, fmt.Sprintf("%s holds %g lb but can only hold %g lb", catalogItemName(catalog, container), held, capacity))
+		}
+	}
+
+	return errors
+}
+
+// applyContainers derives how much weight each container holds
+func (e *Engine) applyContainers(character *models.Character) {
+	inventory := character.Inventory
+	for i := range inventory.Items {
+		item := &inventory.Items[i]
+		item.ContentsWeight = 0
+		if itemContainer(item.Item) != nil {
+			item.ContentsWeight = contentsWeight(e.catalog, inventory, item)
+		}
+	}
+}
+
+// catalogItemName is ItemName for an item whose stats may not be resolved yet
+func catalogItemName(catalog *reference.Catalog, item *models.InventoryItem) string {
+	if item.Name == "" {
+		if stats := ItemStats(catalog, item); stats != nil {
+			return stats.Name
+		}
+	}
+	return ItemName(item)
+}
+
+// containerOf returns the item another item is packed in, or nil when it
+// isn't packed or its container is missing
+func containerOf(inventory *models.Inventory, item *models.InventoryItem) *models.InventoryItem {
+	if item.ContainerID == "" {
+		return nil
+	}
+	for i := range inventory.Items {
+		if inventory.Items[i].ID == item.ContainerID {
+			return &inventory.Items[i]
+		}
+	}
+	return nil
+}
+
+// packedInside reports whether item is container or any container it is
+// packed in, following at most one step per inventory item so packing loops
+// that item isn't part of end
+func packedInside(inventory *models.Inventory, container, item *models.InventoryItem) bool {
+	for range inventory.Items {
+		if container == nil {
+			return false
+		}
+		if container == item {
+			return true
+		}
+		container = containerOf(inventory, container)
+	}
+	return false
+}
+
+// addsWeight reports whether an item adds to the weight its holder carries,
+// which it doesn't when it is packed in a weightless container, however
+// deeply
+func addsWeight(catalog *reference.Catalog, inventory *models.Inventory, item *models.InventoryItem) bool {
+	for range inventory.Items {
+		item = containerOf(inventory, item)
+		if item == nil {
+			return true
+		}
+		if stats := itemContainer(ItemStats(catalog, item)); stats != nil && stats.Weightless {
+			return false
+		}
+	}
+	return true
+}
+
+// contentsWeight returns the weight packed in a container, counting what its
+// contents hold in turn unless they are weightless containers
+func contentsWeight(catalog *reference.Catalog, inventory *models.Inventory, container *models.InventoryItem) float64 {
+	return math.Round(packedWeight(catalog, inventory, container, 0)*100) / 100
+}
+
+// packedWeight sums contentsWeight, stopping after one level per inventory
+// item so packing loops end
+func packedWeight(catalog *reference.Catalog, inventory *models.Inventory, container *models.InventoryItem, depth int) float64 {
+	if container.ID == "" || depth >= len(inventory.Items) {
+		return 0
+	}
+
+	total := 0.0
+	for i := range inventory.Items {
+		item := &inventory.Items[i]
+		if item == container || item.ContainerID != container.ID {
+			continue
+		}
+		stats := ItemStats(catalog, item)
+		if stats != nil {
+			total += stats.Weight * float64(max(item.Quantity, 1))
+		}
+		if held := itemContainer(stats); held == nil || !held.Weightless {
+			total += packedWeight(catalog, inventory, item, depth+1)
+		}
+	}
+	return total
+}
@@ -0,0 +1,177 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/yourusername/dnd-character-creator/internal/models"
+)
+
+// Kinds of currency transaction
+const (
+	TransactionCredit = "credit"
+	TransactionDebit  = "debit"
+)
+
+// Coin denominations
+const (
+	Copper   = "cp"
+	Silver   = "sp"
+	Electrum = "ep"
+	Gold     = "gp"
+	Platinum = "pp"
+)
+
+// denominations lists each coin from least to most valuable with its value in
+// copper pieces
+var denominations = []struct {
+	name  string
+	value int
+}{
+	{Copper, 1},
+	{Silver, 10},
+	{Electrum, 50},
+	{Gold, 100},
+	{Platinum, 1000},
+}
+
+// changeDenominations are the coins change is given in; electrum is rare
+// enough that merchants don't hand it out
+var changeDenominations = []string{Gold, Silver, Copper}
+
+// CoinValue returns the value in copper pieces of a denomination
+func CoinValue(denomination string) (int, bool) {
+	for _, d := range denominations {
+		if d.name == denomination {
+			return d.value, true
+		}
+	}
+	return 0, false
+}
+
+// CurrencyValue returns the total value in copper pieces of a purse
+func CurrencyValue(currency *models.Currency) int {
+	if currency == nil {
+		return 0
+	}
+	total := 0
+	for _, d := range denominations {
+		total += *coins(currency, d.name) * d.value
+	}
+	return total
+}
+
+// Transact credits or debits the character's purse and records the
+// transaction in the currency ledger. Debits are paid with coins of the
+// requested denomination and smaller first, then by breaking the smallest
+// larger coin that covers the rest, with change given back in gold, silver
+// and copper.
+func (e *Engine) Transact(character *models.Character, transaction models.CurrencyTransaction) (*models.CurrencyTransactionResult, error) {
+	value, ok := CoinValue(transaction.Denomination)
+	if !ok {
+		return nil, fmt.Errorf("unknown denomination %q", transaction.Denomination)
+	}
+	if transaction.Amount <= 0 {
+		return nil, errors.New("transaction amount must be positive")
+	}
+
+	if character.Inventory == nil {
+		character.Inventory = &models.Inventory{}
+	}
+	if character.Inventory.Currency == nil {
+		character.Inventory.Currency = &models.Currency{}
+	}
+	purse := character.Inventory.Currency
+
+	switch transaction.Type {
+	case TransactionCredit:
+		transaction.Received = &models.Currency{}
+		*coins(transaction.Received, transaction.Denomination) = transaction.Amount
+		*coins(purse, transaction.Denomination) += transaction.Amount
+
+	case TransactionDebit:
+		cost := transaction.Amount * value
+		if worth := CurrencyValue(purse); worth < cost {
+			return nil, fmt.Errorf("insufficient funds: %d %s is worth %d cp but the purse holds %d cp", transaction.Amount, transaction.Denomination, cost, worth)
+		}
+		transaction.Paid, transaction.Received = pay(purse, cost, transaction.Denomination)
+
+	default:
+		return nil, fmt.Errorf("unknown transaction type %q", transaction.Type)
+	}
+
+	transaction.Balance = *purse
+	character.CurrencyLedger = append(character.CurrencyLedger, transaction)
+
+	e.Apply(character)
+
+	return &models.CurrencyTransactionResult{Character: character, Transaction: transaction}, nil
+}
+
+// pay removes coins worth cost copper pieces from a purse that holds enough,
+// returning the coins paid and the change received
+func pay(purse *models.Currency, cost int, denomination string) (*models.Currency, *models.Currency) {
+	paid := &models.Currency{}
+	remaining := cost
+
+	// Coins that fit exactly, from the requested denomination down
+	limit, _ := CoinValue(denomination)
+	for i := len(denominations) - 1; i >= 0 && remaining > 0; i-- {
+		d := denominations[i]
+		if d.value > limit {
+			continue
+		}
+		count := min(*coins(purse, d.name), remaining/d.value)
+		*coins(purse, d.name) -= count
+		*coins(paid, d.name) += count
+		remaining -= count * d.value
+	}
+
+	// Break the smallest coin that covers the rest, or hand over the largest
+	// coin while none does
+	for remaining > 0 {
+		var coin string
+		for _, d := range denominations {
+			if *coins(purse, d.name) == 0 {
+				continue
+			}
+			coin = d.name
+			if d.value >= remaining {
+				break
+			}
+		}
+		value, _ := CoinValue(coin)
+		*coins(purse, coin) -= 1
+		*coins(paid, coin) += 1
+		remaining -= value
+	}
+
+	var received *models.Currency
+	if change := -remaining; change > 0 {
+		received = &models.Currency{}
+		for _, name := range changeDenominations {
+			value, _ := CoinValue(name)
+			*coins(received, name) = change / value
+			*coins(purse, name) += change / value
+			change %= value
+		}
+	}
+
+	return paid, received
+}
+
+// coins returns the counter in a purse for a denomination
+func coins(currency *models.Currency, denomination string) *int {
+	switch denomination {
+	case Silver:
+		return &currency.Silver
+	case Electrum:
+		return &currency.Electrum
+	case Gold:
+		return &currency.Gold
+	case Platinum:
+		return &currency.Platinum
+	default:
+		return &currency.Copper
+	}
+}
@@ -0,0 +1,188 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/yourusername/dnd-character-creator/internal/models"
+)
+
+// Kinds of hit point change
+const (
+	ChangeDamage    = "damage"
+	ChangeHealing   = "healing"
+	ChangeTemporary = "temporary"
+)
+
+// Ways a damage type can alter the damage a character takes
+const (
+	AdjustmentImmunity      = "immunity"
+	AdjustmentResistance    = "resistance"
+	AdjustmentVulnerability = "vulnerability"
+)
+
+// DamageTypes lists the damage types from the Player's Handbook
+var DamageTypes = []string{
+	"acid", "bludgeoning", "cold", "fire", "force", "lightning", "necrotic",
+	"piercing", "poison", "psychic", "radiant", "slashing", "thunder",
+}
+
+// IsDamageType reports whether name is one of the Player's Handbook damage
+// types
+func IsDamageType(name string) bool {
+	return slices.Contains(DamageTypes, strings.ToLower(strings.TrimSpace(name)))
+}
+
+// ChangeHitPoints applies damage, healing or temporary hit points following
+// the Player's Handbook. Damage is adjusted for resistances, immunities and
+// vulnerabilities and comes off temporary hit points first. Dropping to 0 hit
+// points starts death saving throws, unless the remaining damage is at least
+// the hit point maximum, which kills the character outright.
+func (e *Engine) ChangeHitPoints(character *models.Character, request *models.HitPointChangeRequest) (*models.HitPointChangeResult, error) {
+	if request.Amount < 0 {
+		return nil, errors.New("amount cannot be negative")
+	}
+	if request.DamageType != "" && !IsDamageType(request.DamageType) {
+		return nil, fmt.Errorf("unknown damage type %q", request.DamageType)
+	}
+
+	e.Apply(character)
+	if character.Status == StatusDead {
+		return nil, errors.New("character is dead")
+	}
+
+	result := &models.HitPointChangeResult{
+		Character: character,
+		Type:      request.Type,
+		Amount:    request.Amount,
+		Adjusted:  request.Amount,
+	}
+	hp := &character.HitPoints
+
+	switch request.Type {
+	case ChangeDamage:
+		result.Adjusted, result.Adjustment = e.adjustDamage(character, request.DamageType, request.Amount)
+		e.takeDamage(character, result, request.Critical)
+
+	case ChangeHealing:
+		healing := min(result.Adjusted, hp.Maximum-hp.Current)
+		if hp.Current == 0 && healing > 0 && character.DeathSaves != nil {
+			*character.DeathSaves = models.DeathSaves{}
+		}
+		hp.Current += healing
+		result.Change = healing
+
+	case ChangeTemporary:
+		// Temporary hit points don't stack; the character keeps the larger
+		if result.Adjusted > hp.Temporary {
+			result.Change = result.Adjusted - hp.Temporary
+			hp.Temporary = result.Adjusted
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown hit point change type %q", request.Type)
+	}
+
+	applyStatus(character)
+	result.Dead = character.Status == StatusDead
+	result.Dying = character.Status == StatusDying
+	return result, nil
+}
+
+// takeDamage removes adjusted damage from temporary then current hit points.
+// Damage taken at 0 hit points is a failed death save, two on a critical hit,
+// and ends stability.
+func (e *Engine) takeDamage(character *models.Character, result *models.HitPointChangeResult, critical bool) {
+	hp := &character.HitPoints
+	damage := result.Adjusted
+
+	result.TemporaryAbsorbed = min(hp.Temporary, damage)
+	hp.Temporary -= result.TemporaryAbsorbed
+	damage -= result.TemporaryAbsorbed
+	if damage == 0 {
+		return
+	}
+
+	if character.DeathSaves == nil {
+		character.DeathSaves = &models.DeathSaves{}
+	}
+	saves := character.DeathSaves
+
+	if hp.Current == 0 {
+		if damage >= hp.Maximum {
+			saves.Dead = true
+			result.InstantDeath = true
+			return
+		}
+		// A stable character taking damage starts dying again
+		saves.Stable = false
+		saves.Failures = min(saves.Failures+1, 3)
+		if critical {
+			saves.Failures = min(saves.Failures+1, 3)
+		}
+		saves.Dead = saves.Failures == 3
+		return
+	}
+
+	result.Change = -min(damage, hp.Current)
+	overflow := damage - hp.Current
+	hp.Current += result.Change
+	if hp.Current > 0 {
+		return
+	}
+
+	// Falling unconscious ends concentration
+	endConcentration(character)
+	*saves = models.DeathSaves{}
+	if overflow >= hp.Maximum {
+		saves.Dead = true
+		result.InstantDeath = true
+	}
+}
+
+// adjustDamage applies the character's immunity, resistance or vulnerability
+// to a damage type. Resistance halves damage rounding down and vulnerability
+// doubles it; a character with both takes the halved damage doubled.
+func (e *Engine) adjustDamage(character *models.Character, damageType string, amount int) (int, string) {
+	if damageType == "" {
+		return amount, ""
+	}
+	damageType = strings.ToLower(strings.TrimSpace(damageType))
+
+	if containsFold(character.DamageImmunities, damageType) {
+		return 0, AdjustmentImmunity
+	}
+
+	var adjustment string
+	if containsFold(e.DamageResistances(character), damageType) {
+		amount /= 2
+		adjustment = AdjustmentResistance
+	}
+	if containsFold(character.DamageVulnerabilities, damageType) {
+		amount *= 2
+		if adjustment == "" {
+			adjustment = AdjustmentVulnerability
+		}
+	}
+	return amount, adjustment
+}
+
+// DamageResistances returns the character's own damage resistances along with
+// those granted by its race
+func (e *Engine) DamageResistances(character *models.Character) []string {
+	resistances := slices.Clone(character.DamageResistances)
+	if e.catalog == nil {
+		return resistances
+	}
+
+	if race, ok := e.catalog.Race(character.Race); ok {
+		for _, resistance := range race.Resistances {
+			if !containsFold(resistances, resistance) {
+				resistances = append(resistances, resistance)
+			}
+		}
+	}
+	return resistances
+}
@@ -0,0 +1,93 @@
+package rules
+
+import (
+	"errors"
+
+	"github.com/yourusername/dnd-character-creator/internal/models"
+)
+
+// Character statuses shown on the roster
+const (
+	StatusConscious = "conscious"
+	StatusDying     = "dying"
+	StatusStable    = "stable"
+	StatusDead      = "dead"
+)
+
+// Death saving throw outcomes
+const (
+	SaveSuccess         = "success"
+	SaveFailure         = "failure"
+	SaveCriticalSuccess = "criticalSuccess"
+	SaveCriticalFailure = "criticalFailure"
+)
+
+// DeathSaveDC is the number a death saving throw must meet or beat
+const DeathSaveDC = 10
+
+// DeathSave resolves a death saving throw for a dying character using a d20
+// from roll. A natural 1 counts as two failures and a natural 20 restores 1
+// hit point. Three successes stabilize the character and three failures kill
+// it.
+func (e *Engine) DeathSave(character *models.Character, roll func(int) int) (*models.DeathSaveResult, error) {
+	e.Apply(character)
+
+	switch character.Status {
+	case StatusDead:
+		return nil, errors.New("character is dead")
+	case StatusStable:
+		return nil, errors.New("character is stable and does not make death saving throws")
+	case StatusConscious:
+		return nil, errors.New("character is not dying")
+	}
+
+	result := &models.DeathSaveResult{Character: character, Roll: roll(20)}
+	saves := character.DeathSaves
+
+	switch {
+	case result.Roll == 20:
+		result.Outcome = SaveCriticalSuccess
+		*saves = models.DeathSaves{}
+		character.HitPoints.Current = 1
+	case result.Roll == 1:
+		result.Outcome = SaveCriticalFailure
+		saves.Failures = min(saves.Failures+2, 3)
+	case result.Roll >= DeathSaveDC:
+		result.Outcome = SaveSuccess
+		saves.Successes++
+	default:
+		result.Outcome = SaveFailure
+		saves.Failures++
+	}
+
+	if saves.Failures >= 3 {
+		saves.Dead = true
+	} else if saves.Successes >= 3 {
+		// Stabilizing resets both counters
+		*saves = models.DeathSaves{Stable: true}
+	}
+
+	e.Apply(character)
+	result.Status = character.Status
+	return result, nil
+}
+
+// applyStatus records whether the character is conscious, dying, stable or
+// dead. Six levels of exhaustion are fatal. Characters at 0 hit points always
+// have death saves to track.
+func applyStatus(character *models.Character) {
+	saves := character.DeathSaves
+	switch {
+	case saves != nil && saves.Dead, character.Exhaustion >= MaxExhaustion:
+		character.Status = StatusDead
+	case character.HitPoints.Current > 0:
+		character.Status = StatusConscious
+	case saves != nil && saves.Stable:
+		character.Status = StatusStable
+	default:
+		if saves == nil {
+			character.DeathSaves = &models.DeathSaves{}
+		}
+		character.Status = StatusDying
+	}
+}
@@ -0,0 +1,159 @@
+package rules
+
+import (
+	"math"
+	"strings"
+
+	"github.com/yourusername/dnd-character-creator/internal/models"
+)
+
+// Encumbrance statuses under the variant encumbrance rule
+const (
+	EncumbranceNone         = "unencumbered"
+	EncumbranceEncumbered   = "encumbered"
+	EncumbranceHeavy        = "heavilyEncumbered"
+	EncumbranceOverCapacity = "overCapacity"
+)
+
+// CoinsPerPound is how many coins of any denomination weigh one pound
+const CoinsPerPound = 50
+
+// overCapacitySpeed is the speed of a creature carrying more than its capacity
+const overCapacitySpeed = 5
+
+// SizeMultiplier returns the carrying capacity multiplier for a creature size
+func SizeMultiplier(size string) float64 {
+	switch strings.ToLower(size) {
+	case "tiny":
+		return 0.5
+	case "large":
+		return 2
+	case "huge":
+		return 4
+	case "gargantuan":
+		return 8
+	default:
+		return 1
+	}
+}
+
+// CurrencyWeight returns the weight in pounds of a coin purse
+func CurrencyWeight(currency *models.Currency) float64 {
+	if currency == nil {
+		return 0
+	}
+	coins := currency.Copper + currency.Silver + currency.Electrum + currency.Gold + currency.Platinum
+	return float64(coins) / CoinsPerPound
+}
+
+// CarriedWeight sums the weight of all items and coins, taking weights from
+// the catalog for free-text entries that do not record one. Items packed in a
+// weightless container don't count.
+func (e *Engine) CarriedWeight(inventory *models.Inventory) float64 {
+	if inventory == nil {
+		return 0
+	}
+
+	total := CurrencyWeight(inventory.Currency)
+
+	for _, item := range inventory.Equipment {
+		total += e.itemWeight(item.Name, item.Weight) * float64(max(item.Quantity, 1))
+	}
+	for _, weapon := range inventory.Weapons {
+		total += e.itemWeight(weapon.Name, weapon.Weight) * float64(max(weapon.Quantity, 1))
+	}
+	for _, armor := range inventory.Armor {
+		total += e.itemWeight(armor.Name, armor.Weight)
+	}
+	for i := range inventory.Items {
+		item := &inventory.Items[i]
+		if stats := ItemStats(e.catalog, item); stats != nil && addsWeight(e.catalog, inventory, item) {
+			total += stats.Weight * float64(max(item.Quantity, 1))
+		}
+	}
+
+	return math.Round(total*100) / 100
+}
+
+// applyEncumbrance computes carried weight, carrying capacity and the variant
+// encumbrance status. Speed penalties are only applied when the variant rule
+// is enabled.
+func (e *Engine) applyEncumbrance(character *models.Character) {
+	strength := float64(character.AbilityScores.Strength.Score)
+	multiplier := SizeMultiplier(e.creatureSize(character))
+
+	encumbrance := &models.Encumbrance{
+		CarriedWeight:              e.CarriedWeight(character.Inventory),
+		CarryingCapacity:           strength * 15 * multiplier,
+		EncumberedThreshold:        strength * 5 * multiplier,
+		HeavilyEncumberedThreshold: strength * 10 * multiplier,
+		Status:                     EncumbranceNone,
+	}
+
+	switch {
+	case encumbrance.CarriedWeight > encumbrance.CarryingCapacity:
+		encumbrance.Status = EncumbranceOverCapacity
+	case encumbrance.CarriedWeight > encumbrance.HeavilyEncumberedThreshold:
+		encumbrance.Status = EncumbranceHeavy
+		if e.cfg.VariantEncumbrance {
+			encumbrance.SpeedPenalty = 20
+		}
+	case encumbrance.CarriedWeight > encumbrance.EncumberedThreshold:
+		encumbrance.Status = EncumbranceEncumbered
+		if e.cfg.VariantEncumbrance {
+			encumbrance.SpeedPenalty = 10
+		}
+	}
+
+	character.Encumbrance = encumbrance
+	if character.Inventory != nil {
+		character.Inventory.CarryingCapacity = int(encumbrance.CarryingCapacity)
+	}
+}
+
+// applySpeed derives effective speed from base speed, encumbrance and heavy
+// armor worn without the Strength it requires
+func (e *Engine) applySpeed(character *models.Character) {
+	effective := character.Speed
+	penalty := e.armorSpeedPenalty(character)
+	overCapacity := false
+	if character.Encumbrance != nil {
+		penalty += character.Encumbrance.SpeedPenalty
+		overCapacity = character.Encumbrance.Status == EncumbranceOverCapacity
+	}
+
+	for _, speed := range []*int{&effective.Walk, &effective.Fly, &effective.Swim, &effective.Climb, &effective.Burrow} {
+		if *speed == 0 {
+			continue
+		}
+		*speed = max(*speed-penalty, 0)
+		if overCapacity {
+			*speed = min(*speed, overCapacitySpeed)
+		}
+	}
+
+	character.EffectiveSpeed = &effective
+}
+
+// itemWeight returns the recorded weight of an item, or its catalog weight
+// when none is recorded
+func (e *Engine) itemWeight(name string, weight float64) float64 {
+	if weight > 0 || e.catalog == nil {
+		return weight
+	}
+	if entry, ok := e.catalog.Equipment(name); ok {
+		return entry.Weight
+	}
+	return 0
+}
+
+// creatureSize returns the character's size from its race, defaulting to
+// Medium for races missing from the catalog
+func (e *Engine) creatureSize(character *models.Character) string {
+	if e.catalog != nil {
+		if race, ok := e.catalog.Race(character.Race); ok {
+			return race.Size
+		}
+	}
+	return "medium"
+}
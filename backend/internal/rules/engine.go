@@ -0,0 +1,106 @@
+package rules
+
+import (
+	"github.com/yourusername/dnd-character-creator/internal/config"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/reference"
+)
+
+// Engine computes every derived number on a character sheet from its
+// ability scores, level and proficiencies
+type Engine struct {
+	catalog *reference.Catalog
+	cfg     config.RulesConfig
+}
+
+// NewEngine creates a new rules engine. Calculations that need class data,
+// such as spell slots, are skipped when catalog is nil.
+func NewEngine(catalog *reference.Catalog, cfg config.RulesConfig) *Engine {
+	return &Engine{
+		catalog: catalog,
+		cfg:     cfg,
+	}
+}
+
+// Apply recalculates all derived stats on the character in place, overwriting
+// any client-supplied values
+func (e *Engine) Apply(character *models.Character) {
+	e.ResolveAbilityScores(character)
+
+	character.ClassLevel = PrimaryClassLevel(character)
+	character.ProficiencyBonus = ProficiencyBonus(character.Level)
+	e.applyExperience(character)
+
+	applySavingThrows(character)
+	applySkills(character)
+
+	character.Initiative = character.AbilityScores.Dexterity.Modifier
+	character.PassivePerception = 10 + character.Skills.Perception.Modifier
+
+	e.applyHitPoints(character)
+	e.applyHitDice(character)
+	applyStatus(character)
+	e.applyInventory(character)
+	e.applyWeaponAttacks(character)
+	e.applyArmorClass(character)
+	e.applyEncumbrance(character)
+	e.applySpeed(character)
+	e.applyItemModifiers(character)
+	applyConditions(character)
+
+	e.applySpellSlots(character)
+	e.applySpellcasting(character)
+	e.applyResources(character)
+}
+
+// ResolveAbilityScores computes final ability scores and modifiers from their
+// sources, including magic items. Validation needs them before the rest of the
+// sheet is derived.
+func (e *Engine) ResolveAbilityScores(character *models.Character) {
+	e.applyAbilityScores(character)
+	e.applyItemAbilityScores(character)
+	applyAbilityModifiers(&character.AbilityScores)
+}
+
+// applySavingThrows sets each saving throw bonus to the ability modifier plus
+// the proficiency bonus when the character is proficient in that save
+func applySavingThrows(character *models.Character) {
+	scores := &character.AbilityScores
+	proficient := models.SavingThrows{}
+	if character.SavingThrows != nil {
+		proficient = *character.SavingThrows
+	}
+
+	bonus := func(modifier int, isProficient bool) int {
+		if isProficient {
+			return modifier + character.ProficiencyBonus
+		}
+		return modifier
+	}
+
+	character.SavingThrowBonuses = models.SavingThrowBonuses{
+		Strength:     bonus(scores.Strength.Modifier, proficient.Strength),
+		Dexterity:    bonus(scores.Dexterity.Modifier, proficient.Dexterity),
+		Constitution: bonus(scores.Constitution.Modifier, proficient.Constitution),
+		Intelligence: bonus(scores.Intelligence.Modifier, proficient.Intelligence),
+		Wisdom:       bonus(scores.Wisdom.Modifier, proficient.Wisdom),
+		Charisma:     bonus(scores.Charisma.Modifier, proficient.Charisma),
+	}
+}
+
+// applySpellcasting derives spell save DC and spell attack bonus from the
+// spellcasting ability, when one is set, and magic items
+func (e *Engine) applySpellcasting(character *models.Character) {
+	if character.Spellcasting == nil {
+		return
+	}
+
+	modifier, ok := abilityModifierByName(&character.AbilityScores, character.Spellcasting.SpellcastingAbility)
+	if !ok {
+		return
+	}
+
+	items := activeModifiers(e.catalog, character.Inventory)
+	character.Spellcasting.SpellSaveDC = 8 + character.ProficiencyBonus + modifier + modifierBonus(items, ModifierSpellSaveDC, nil)
+	character.Spellcasting.SpellAttackBonus = character.ProficiencyBonus + modifier + modifierBonus(items, ModifierSpellAttack, nil)
+}
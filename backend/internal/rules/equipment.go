@@ -0,0 +1,318 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/reference"
+)
+
+// Limits on what a character can use at once
+const (
+	Hands           = 2
+	MaxAttunedItems = 3
+)
+
+// heavyArmorSpeedPenalty is how much heavy armor slows a wearer who doesn't
+// meet its Strength requirement
+const heavyArmorSpeedPenalty = 10
+
+// Weapon properties that affect attacks and hands
+const (
+	propertyFinesse   = "finesse"
+	propertyTwoHanded = "two-handed"
+	propertyVersatile = "versatile"
+)
+
+// weaponProficiencies lists the weapons each class is proficient with, either
+// a weapon category ("simple" or "martial") or a catalog weapon ID
+var weaponProficiencies = map[string][]string{
+	"barbarian": {"simple", "martial"},
+	"bard":      {"simple", "hand-crossbow", "longsword", "rapier", "shortsword"},
+	"cleric":    {"simple"},
+	"druid":     {"club", "dagger", "dart", "javelin", "mace", "quarterstaff", "scimitar", "sickle", "sling", "spear"},
+	"fighter":   {"simple", "martial"},
+	"monk":      {"simple", "shortsword"},
+	"paladin":   {"simple", "martial"},
+	"ranger":    {"simple", "martial"},
+	"rogue":     {"simple", "hand-crossbow", "longsword", "rapier", "shortsword"},
+	"sorcerer":  {"dagger", "dart", "sling", "quarterstaff", "light-crossbow"},
+	"warlock":   {"simple"},
+	"wizard":    {"dagger", "dart", "sling", "quarterstaff", "light-crossbow"},
+}
+
+// Equip equips an inventory item, attuning to it when the request asks. A
+// packed item is taken out of its container. It fails when the item would
+// break a rule on what can be worn, held or attuned at once that the
+// character didn't already break.
+func (e *Engine) Equip(character *models.Character, id string, request *models.EquipRequest) error {
+	e.Apply(character)
+
+	item := findInventoryItem(character, id)
+	if item == nil {
+		return fmt.Errorf("character has no item %q", id)
+	}
+	name := ItemName(item)
+	if item.Item == nil {
+		return fmt.Errorf("%s has no stats and cannot be equipped", name)
+	}
+	if request.Attune {
+		if !RequiresAttunement(item.Item) {
+			return fmt.Errorf("%s does not require attunement", name)
+		}
+		if err := e.checkAttunementPrerequisites(character, name, item.Item); err != nil {
+			return err
+		}
+	}
+
+	before := EquipmentErrors(e.catalog, character.Inventory)
+	previous := *item
+	item.Equipped = true
+	item.Attuned = item.Attuned || request.Attune
+	item.ContainerID = ""
+
+	var problems []string
+	for _, problem := range EquipmentErrors(e.catalog, character.Inventory) {
+		if !slices.Contains(before, problem) {
+			problems = append(problems, problem)
+		}
+	}
+	if len(problems) > 0 {
+		*item = previous
+		return fmt.Errorf("cannot equip %s: %s", name, strings.Join(problems, "; "))
+	}
+
+	e.Apply(character)
+	return nil
+}
+
+// Unequip unequips an inventory item, ending attunement to it when the
+// request asks
+func (e *Engine) Unequip(character *models.Character, id string, request *models.UnequipRequest) error {
+	e.Apply(character)
+
+	item := findInventoryItem(character, id)
+	if item == nil {
+		return fmt.Errorf("character has no item %q", id)
+	}
+	if !item.Equipped && !(request.EndAttunement && item.Attuned) {
+		return fmt.Errorf("%s is not equipped", ItemName(item))
+	}
+
+	item.Equipped = false
+	if request.EndAttunement {
+		item.Attuned = false
+	}
+
+	e.Apply(character)
+	return nil
+}
+
+// EquipmentErrors checks what an inventory has equipped and attuned: one body
+// armor, one shield, no more weapons and shields than two hands can hold, no
+// shield alongside a two-handed weapon and at most three attuned items
+func EquipmentErrors(catalog *reference.Catalog, inventory *models.Inventory) []string {
+	if inventory == nil {
+		return nil
+	}
+
+	var errors []string
+	body, shields := EquippedArmor(catalog, inventory)
+	if len(body) > 1 {
+		errors = append(errors, "only one body armor can be equipped at a time")
+	}
+	if len(shields) > 1 {
+		errors = append(errors, "only one shield can be equipped at a time")
+	}
+
+	hands := len(shields)
+	twoHanded := false
+	for _, properties := range equippedWeaponProperties(catalog, inventory) {
+		if slices.Contains(properties, propertyTwoHanded) {
+			hands += 2
+			twoHanded = true
+		} else {
+			hands++
+		}
+	}
+	switch {
+	case twoHanded && len(shields) > 0:
+		errors = append(errors, "a two-handed weapon can't be wielded while holding a shield")
+	case hands > Hands:
+		errors = append(errors, fmt.Sprintf("equipped weapons and shields need %d hands but a character only has %d", hands, Hands))
+	}
+
+	attuned := 0
+	for i := range inventory.Items {
+		item := &inventory.Items[i]
+		if !item.Attuned {
+			continue
+		}
+		attuned++
+		if stats := ItemStats(catalog, item); stats != nil && !RequiresAttunement(stats) {
+			errors = append(errors, fmt.Sprintf("%s does not require attunement", ItemName(item)))
+		}
+	}
+	if attuned > MaxAttunedItems {
+		errors = append(errors, fmt.Sprintf("a character can be attuned to at most %d magic items", MaxAttunedItems))
+	}
+
+	return errors
+}
+
+// equippedWeaponProperties returns the lowercased properties of each equipped
+// weapon, falling back to the catalog for free-text weapons that record none
+func equippedWeaponProperties(catalog *reference.Catalog, inventory *models.Inventory) [][]string {
+	var weapons [][]string
+	for _, weapon := range inventory.Weapons {
+		if !weapon.Equipped {
+			continue
+		}
+		properties := weapon.Properties
+		if len(properties) == 0 && catalog != nil {
+			if entry, ok := catalog.Equipment(weapon.Name); ok {
+				properties = entry.Properties
+			}
+		}
+		weapons = append(weapons, lowerAll(properties))
+	}
+	for i := range inventory.Items {
+		item := &inventory.Items[i]
+		stats := ItemStats(catalog, item)
+		if item.Equipped && stats != nil && stats.Category == "weapon" {
+			weapons = append(weapons, lowerAll(stats.Properties))
+		}
+	}
+	return weapons
+}
+
+// applyWeaponAttacks replaces the attacks derived from weapons with one for
+// each equipped weapon item. Finesse weapons use the better of Strength and
+// Dexterity and ranged weapons use Dexterity. A magic weapon adds its own
+// attack and damage modifiers. Attacks entered by hand are kept ahead of them
+// so their positions don't change.
+func (e *Engine) applyWeaponAttacks(character *models.Character) {
+	attacks := slices.DeleteFunc(character.Attacks, func(a models.Attack) bool {
+		return a.ItemID != ""
+	})
+	if character.Inventory == nil {
+		character.Attacks = attacks
+		return
+	}
+
+	scores := &character.AbilityScores
+	magic := activeModifiers(e.catalog, character.Inventory)
+	for i := range character.Inventory.Items {
+		item := &character.Inventory.Items[i]
+		stats := item.Item
+		if !item.Equipped || stats == nil || stats.Category != "weapon" || stats.Damage == "" {
+			continue
+		}
+
+		properties := lowerAll(stats.Properties)
+		modifier := scores.Strength.Modifier
+		switch {
+		case strings.Contains(stats.WeaponCategory, "ranged"):
+			modifier = scores.Dexterity.Modifier
+		case slices.Contains(properties, propertyFinesse):
+			modifier = max(scores.Strength.Modifier, scores.Dexterity.Modifier)
+		}
+
+		bonus := modifier + modifierBonus(magic, ModifierAttack, item)
+		if e.proficientWith(character, stats) {
+			bonus += character.ProficiencyBonus
+		}
+		modifier += modifierBonus(magic, ModifierDamage, item)
+
+		notes := make([]string, 0, len(properties))
+		for _, property := range properties {
+			if property == propertyVersatile && stats.VersatileDamage != "" {
+				property += " (" + damageWithModifier(stats.VersatileDamage, modifier) + ")"
+			}
+			notes = append(notes, property)
+		}
+
+		attacks = append(attacks, models.Attack{
+			Name:        ItemName(item),
+			AttackBonus: bonus,
+			Damage:      damageWithModifier(stats.Damage, modifier),
+			DamageType:  stats.DamageType,
+			Notes:       strings.Join(notes, ", "),
+			ItemID:      item.ID,
+		})
+	}
+	character.Attacks = attacks
+}
+
+// proficientWith reports whether any of the character's classes grants
+// proficiency with a weapon
+func (e *Engine) proficientWith(character *models.Character, weapon *models.Item) bool {
+	category, _, _ := strings.Cut(weapon.WeaponCategory, " ")
+	for _, cl := range ClassLevels(character) {
+		for _, proficiency := range weaponProficiencies[e.classID(cl.Class)] {
+			if proficiency == category || proficiency == weapon.ID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// armorSpeedPenalty returns how far equipped heavy armor slows the character
+// when its Strength score is below the armor's requirement. Dwarves aren't
+// slowed by heavy armor.
+func (e *Engine) armorSpeedPenalty(character *models.Character) int {
+	if e.catalog != nil {
+		if race, ok := e.catalog.Race(character.Race); ok && race.ID == "dwarf" {
+			return 0
+		}
+	}
+
+	body, _ := EquippedArmor(e.catalog, character.Inventory)
+	if len(body) == 0 || ArmorCategory(e.catalog, body[0]) != ArmorHeavy {
+		return 0
+	}
+
+	requirement := body[0].StrengthRequirement
+	if requirement == 0 && e.catalog != nil {
+		if entry, ok := e.catalog.Equipment(body[0].Name); ok {
+			requirement = entry.StrengthRequirement
+		}
+	}
+	if character.AbilityScores.Strength.Score < requirement {
+		return heavyArmorSpeedPenalty
+	}
+	return 0
+}
+
+// findInventoryItem returns the inventory item with the given ID
+func findInventoryItem(character *models.Character, id string) *models.InventoryItem {
+	if character.Inventory == nil {
+		return nil
+	}
+	for i := range character.Inventory.Items {
+		if character.Inventory.Items[i].ID == id {
+			return &character.Inventory.Items[i]
+		}
+	}
+	return nil
+}
+
+// damageWithModifier adds an ability modifier to damage notation
+func damageWithModifier(damage string, modifier int) string {
+	if modifier == 0 {
+		return damage
+	}
+	return fmt.Sprintf("%s%+d", damage, modifier)
+}
+
+// lowerAll returns a lowercased copy of values
+func lowerAll(values []string) []string {
+	lowered := make([]string, len(values))
+	for i, value := range values {
+		lowered[i] = strings.ToLower(value)
+	}
+	return lowered
+}
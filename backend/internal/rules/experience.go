@@ -0,0 +1,90 @@
+package rules
+
+import (
+	"errors"
+
+	"github.com/yourusername/dnd-character-creator/internal/models"
+)
+
+// Advancement modes
+const (
+	AdvancementXP        = "xp"
+	AdvancementMilestone = "milestone"
+)
+
+// experienceThresholds is the total experience needed for each level from the
+// Player's Handbook, indexed by level minus one
+var experienceThresholds = [MaxLevel]int{
+	0, 300, 900, 2700, 6500, 14000, 23000, 34000, 48000, 64000,
+	85000, 100000, 120000, 140000, 165000, 195000, 225000, 265000, 305000, 355000,
+}
+
+// ExperienceForLevel returns the total experience needed to reach a level
+func ExperienceForLevel(level int) int {
+	return experienceThresholds[clamp(level, 1, MaxLevel)-1]
+}
+
+// LevelForExperience returns the highest level an experience total reaches
+func LevelForExperience(experience int) int {
+	level := 1
+	for i, threshold := range experienceThresholds {
+		if experience >= threshold {
+			level = i + 1
+		}
+	}
+	return level
+}
+
+// Advancement returns how the character levels: its own setting, or the
+// deployment default when it has none
+func (e *Engine) Advancement(character *models.Character) string {
+	if character.Advancement != "" {
+		return character.Advancement
+	}
+	if e.cfg.AdvancementMode == AdvancementMilestone {
+		return AdvancementMilestone
+	}
+	return AdvancementXP
+}
+
+// AwardExperience appends an award to the character's experience ledger and
+// reports whether it crossed a level threshold. Characters using milestone
+// levelling do not track experience.
+func (e *Engine) AwardExperience(character *models.Character, award models.ExperienceAward) (*models.ExperienceAwardResult, error) {
+	if e.Advancement(character) == AdvancementMilestone {
+		return nil, errors.New("character uses milestone levelling and does not track experience points")
+	}
+	if award.Amount == 0 {
+		return nil, errors.New("experience award amount must not be zero")
+	}
+
+	before := LevelForExperience(character.ExperiencePoints)
+
+	award.Total = max(character.ExperiencePoints+award.Amount, 0)
+	character.ExperiencePoints = award.Total
+	character.ExperienceLedger = append(character.ExperienceLedger, award)
+
+	e.Apply(character)
+
+	eligible := LevelForExperience(character.ExperiencePoints)
+	result := &models.ExperienceAwardResult{
+		Character:        character,
+		Award:            award,
+		ThresholdCrossed: eligible > before,
+		LevelUpAvailable: character.LevelUpAvailable,
+		EligibleLevel:    eligible,
+	}
+	if eligible < MaxLevel {
+		result.NextLevelExperience = ExperienceForLevel(eligible + 1)
+	}
+
+	return result, nil
+}
+
+// applyExperience flags characters whose experience has reached a level above
+// their current one
+func (e *Engine) applyExperience(character *models.Character) {
+	character.LevelUpAvailable = e.Advancement(character) == AdvancementXP &&
+		character.Level < MaxLevel &&
+		LevelForExperience(character.ExperiencePoints) > character.Level
+}
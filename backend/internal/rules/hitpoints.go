@@ -0,0 +1,217 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/yourusername/dnd-character-creator/internal/models"
+)
+
+// Hit point growth modes
+const (
+	GrowthFixed  = "fixed"
+	GrowthRolled = "rolled"
+)
+
+// Methods used to determine the hit points gained at a level
+const (
+	HitPointMax     = "max"
+	HitPointAverage = "average"
+	HitPointRolled  = "rolled"
+)
+
+// Features that grant extra hit points per level
+const (
+	featureDwarvenToughness   = "dwarven toughness"
+	featureTough              = "tough"
+	featureDraconicResilience = "draconic resilience"
+)
+
+// AverageHitDie returns the fixed hit points gained per level for a hit die,
+// rounded up as in the Player's Handbook
+func AverageHitDie(hitDie int) int {
+	return hitDie/2 + 1
+}
+
+// HitPointMaximum sums the hit points gained at each level. Every level grants
+// its recorded die result plus the Constitution modifier, minimum 1, so a
+// change to Constitution applies retroactively to every level.
+func HitPointMaximum(history []models.HitPointLevel, conModifier int) int {
+	total := 0
+	for _, level := range history {
+		total += max(level.Roll+conModifier, 1)
+	}
+	return total
+}
+
+// PreserveHitPointHistory keeps the rolls already on record so that updates
+// cannot rewrite past levels. Entries in updated beyond the recorded history
+// are kept as new levels.
+func PreserveHitPointHistory(recorded, updated []models.HitPointLevel) []models.HitPointLevel {
+	if len(recorded) == 0 {
+		return updated
+	}
+
+	history := append([]models.HitPointLevel{}, recorded...)
+	if len(updated) > len(recorded) {
+		history = append(history, updated[len(recorded):]...)
+	}
+	return history
+}
+
+// applyHitPoints derives the hit point maximum. Characters with classes
+// missing from the catalog keep their client-supplied maximum. Exhaustion
+// level 4 halves the maximum either way, and the hit points it takes are
+// recorded as the reduction so the full maximum can be restored.
+func (e *Engine) applyHitPoints(character *models.Character) {
+	hp := &character.HitPoints
+	if hp.Growth == "" {
+		hp.Growth = GrowthFixed
+	}
+
+	maximum := hp.Maximum + hp.Reduction
+	if derived, ok := e.hitPointMaximum(character); ok {
+		maximum = derived
+	}
+
+	hp.Reduction = 0
+	if character.Exhaustion >= 4 {
+		hp.Reduction = maximum - maximum/2
+	}
+	hp.Maximum = maximum - hp.Reduction
+	hp.Current = min(hp.Current, hp.Maximum)
+}
+
+// hitPointMaximum rebuilds the per-level hit point history from the
+// character's class levels and returns the hit point maximum it grants. It
+// reports false when a class is missing from the catalog.
+func (e *Engine) hitPointMaximum(character *models.Character) (int, bool) {
+	if e.catalog == nil {
+		return 0, false
+	}
+
+	levels := ClassLevels(character)
+	remaining := make(map[string]int)
+	hitDice := make(map[string]int)
+	names := make(map[string]string)
+	for _, cl := range levels {
+		class, ok := e.catalog.Class(cl.Class)
+		if !ok {
+			return 0, false
+		}
+		remaining[class.ID] += cl.Level
+		hitDice[class.ID] = class.HitDie
+		names[class.ID] = class.Name
+	}
+
+	hp := &character.HitPoints
+	var history []models.HitPointLevel
+	for _, entry := range hp.History {
+		id := e.classID(entry.Class)
+		if remaining[id] <= 0 {
+			continue
+		}
+		remaining[id]--
+
+		entry.Class = names[id]
+		entry.HitDie = hitDice[id]
+		if entry.Method != HitPointRolled || entry.Roll < 1 || entry.Roll > entry.HitDie {
+			entry.Method = HitPointAverage
+			entry.Roll = AverageHitDie(entry.HitDie)
+		}
+		history = append(history, entry)
+	}
+
+	for _, cl := range levels {
+		id := e.classID(cl.Class)
+		for ; remaining[id] > 0; remaining[id]-- {
+			history = append(history, models.HitPointLevel{
+				Class:  names[id],
+				HitDie: hitDice[id],
+				Roll:   AverageHitDie(hitDice[id]),
+				Method: HitPointAverage,
+			})
+		}
+	}
+
+	if len(history) == 0 {
+		return 0, false
+	}
+
+	// The first character level is always taken in the primary class and
+	// grants the full hit die
+	primary := e.classID(character.Class)
+	for i, entry := range history {
+		if e.classID(entry.Class) == primary {
+			copy(history[1:i+1], history[:i])
+			history[0] = entry
+			break
+		}
+	}
+	history[0].Roll = history[0].HitDie
+	history[0].Method = HitPointMax
+
+	for i := range history {
+		history[i].Level = i + 1
+	}
+
+	hp.History = history
+	return HitPointMaximum(history, character.AbilityScores.Constitution.Modifier) + e.bonusHitPoints(character), true
+}
+
+// bonusHitPoints returns the extra hit points granted by racial traits, feats
+// and subclass features that add to the maximum at every level. Draconic
+// Resilience recorded as a feature only counts for homebrew subclasses.
+func (e *Engine) bonusHitPoints(character *models.Character) int {
+	bonus := 0
+	if e.hasTrait(character, featureDwarvenToughness) {
+		bonus += character.Level
+	}
+	if hasFeature(character, featureTough) {
+		bonus += 2 * character.Level
+	}
+
+	subclassBonus := 0
+	for _, sl := range e.subclassLevels(character) {
+		subclassBonus += sl.subclass.HitPointsPerLevel * sl.level
+	}
+	if subclassBonus == 0 && hasFeature(character, featureDraconicResilience) {
+		subclassBonus = e.classLevel(character, "sorcerer")
+	}
+	return bonus + subclassBonus
+}
+
+// hasTrait reports whether the character's race or subrace grants a trait,
+// or the trait is recorded as a feature for homebrew races
+func (e *Engine) hasTrait(character *models.Character, trait string) bool {
+	if hasFeature(character, trait) {
+		return true
+	}
+
+	var traits []string
+	if race, ok := e.catalog.Race(character.Race); ok {
+		traits = append(traits, race.Traits...)
+	}
+	if character.Subrace != "" {
+		if subrace, ok := e.catalog.Subrace(character.Subrace); ok {
+			traits = append(traits, subrace.Traits...)
+		}
+	}
+
+	for _, t := range traits {
+		if strings.EqualFold(t, trait) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasFeature reports whether the character has a feature or feat with the
+// given name
+func hasFeature(character *models.Character, name string) bool {
+	for _, feature := range character.Features {
+		if strings.EqualFold(strings.TrimSpace(feature.Name), name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,135 @@
+package rules
+
+import (
+	"github.com/yourusername/dnd-character-creator/internal/models"
+)
+
+// Rules versions, which decide whether origin ability score increases come
+// from race (2014) or background (2024)
+const (
+	Rules2014 = "2014"
+	Rules2024 = "2024"
+)
+
+// Sources of ability score increases
+const (
+	IncreaseRace       = "race"
+	IncreaseBackground = "background"
+	IncreaseLevelUp    = "levelUp"
+	IncreaseFeat       = "feat"
+)
+
+// AbilityScoreCap is the highest score that increases can raise an ability to.
+// Overrides from magic items may go higher.
+const AbilityScoreCap = 20
+
+// Abilities lists the six abilities in character sheet order
+var Abilities = []string{"strength", "dexterity", "constitution", "intelligence", "wisdom", "charisma"}
+
+// abilityScoreImprovementLevels are the class levels that grant an Ability
+// Score Improvement, with the extra levels Fighters and Rogues receive
+var abilityScoreImprovementLevels = map[string][]int{
+	"":        {4, 8, 12, 16, 19},
+	"fighter": {4, 6, 8, 12, 14, 16, 19},
+	"rogue":   {4, 8, 10, 12, 16, 19},
+}
+
+// HasBaseScores reports whether the character records base scores. Without
+// them the submitted scores are treated as final.
+func HasBaseScores(scores *models.AbilityScores) bool {
+	for _, ability := range Abilities {
+		if AbilityByName(scores, ability).Base > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// BaseScoreValues lists the six base scores in character sheet order
+func BaseScoreValues(scores *models.AbilityScores) []int {
+	values := make([]int, len(Abilities))
+	for i, ability := range Abilities {
+		values[i] = AbilityByName(scores, ability).Base
+	}
+	return values
+}
+
+// RulesVersion returns the rules version the scores are built with,
+// defaulting to the 2014 rules
+func RulesVersion(scores *models.AbilityScores) string {
+	if scores.RulesVersion == Rules2024 {
+		return Rules2024
+	}
+	return Rules2014
+}
+
+// AbilityScoreImprovements returns how many Ability Score Improvements a class
+// grants up to the given level
+func AbilityScoreImprovements(classID string, level int) int {
+	levels, ok := abilityScoreImprovementLevels[classID]
+	if !ok {
+		levels = abilityScoreImprovementLevels[""]
+	}
+
+	count := 0
+	for _, l := range levels {
+		if l <= level {
+			count++
+		}
+	}
+	return count
+}
+
+// RacialBonuses returns the fixed ability score increases granted by the
+// character's race and subrace under the 2014 rules
+func (e *Engine) RacialBonuses(character *models.Character) map[string]int {
+	bonuses := make(map[string]int)
+	if e.catalog == nil || RulesVersion(&character.AbilityScores) != Rules2014 {
+		return bonuses
+	}
+
+	if race, ok := e.catalog.Race(character.Race); ok {
+		for ability, amount := range race.AbilityBonuses {
+			bonuses[ability] += amount
+		}
+	}
+	if character.Subrace != "" {
+		if subrace, ok := e.catalog.Subrace(character.Subrace); ok {
+			for ability, amount := range subrace.AbilityBonuses {
+				bonuses[ability] += amount
+			}
+		}
+	}
+
+	return bonuses
+}
+
+// applyAbilityScores computes final scores from base scores, racial or
+// background increases, level-up increases and item overrides. Characters
+// without base scores keep their submitted scores.
+func (e *Engine) applyAbilityScores(character *models.Character) {
+	scores := &character.AbilityScores
+	if !HasBaseScores(scores) {
+		return
+	}
+	scores.RulesVersion = RulesVersion(scores)
+
+	increases := e.RacialBonuses(character)
+	for _, increase := range scores.Increases {
+		increases[CanonicalAbility(increase.Ability)] += increase.Amount
+	}
+
+	for _, ability := range Abilities {
+		score := AbilityByName(scores, ability)
+		score.Score = score.Base + increases[ability]
+		if score.Score > AbilityScoreCap {
+			score.Score = max(score.Base, AbilityScoreCap)
+		}
+	}
+
+	for _, override := range scores.Overrides {
+		if score := AbilityByName(scores, override.Ability); score != nil {
+			score.Score = max(score.Score, override.Score)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package rules
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"slices"
+
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/reference"
+)
+
+// Where an item's stats come from
+const (
+	ItemSourceSRD      = "srd"
+	ItemSourceHomebrew = "homebrew"
+)
+
+// CatalogItem converts an SRD equipment entry into item stats
+func CatalogItem(entry *reference.Equipment) *models.Item {
+	item := &models.Item{
+		ID:                  entry.ID,
+		Name:                entry.Name,
+		Source:              ItemSourceSRD,
+		Category:            entry.Category,
+		Weight:              entry.Weight,
+		WeaponCategory:      entry.WeaponCategory,
+		Damage:              entry.Damage,
+		VersatileDamage:     entry.VersatileDamage,
+		DamageType:          entry.DamageType,
+		Properties:          slices.Clone(entry.Properties),
+		ArmorCategory:       entry.ArmorCategory,
+		BaseArmorClass:      entry.BaseArmorClass,
+		DexBonus:            entry.DexBonus,
+		MaxDexBonus:         entry.MaxDexBonus,
+		StrengthRequirement: entry.StrengthRequirement,
+		StealthDisadvantage: entry.StealthDisadvantage,
+	}
+	if entry.Cost.Unit != "" {
+		item.Cost = &models.ItemCost{Quantity: entry.Cost.Quantity, Unit: entry.Cost.Unit}
+	}
+	if entry.Capacity > 0 {
+		item.Container = &models.ItemContainer{Capacity: entry.Capacity}
+	}
+	return item
+}
+
+// IsCatalogItem reports whether id names an SRD item
+func IsCatalogItem(catalog *reference.Catalog, id string) bool {
+	if catalog == nil {
+		return false
+	}
+	_, ok := catalog.Equipment(id)
+	return ok
+}
+
+// ItemStats returns the stats of an inventory item: the SRD entry when the
+// item references one, otherwise the homebrew stats already resolved onto it,
+// or nil when neither is known
+func ItemStats(catalog *reference.Catalog, item *models.InventoryItem) *models.Item {
+	if catalog != nil {
+		if entry, ok := catalog.Equipment(item.ItemID); ok {
+			return CatalogItem(entry)
+		}
+	}
+	return item.Item
+}
+
+// ItemName returns the name an inventory item is shown with: its own name
+// when it has one, otherwise the name of the item it references
+func ItemName(item *models.InventoryItem) string {
+	if item.Name != "" {
+		return item.Name
+	}
+	if item.Item != nil {
+		return item.Item.Name
+	}
+	return item.ItemID
+}
+
+// applyInventory resolves the stats of each inventory item, gives new items an
+// ID so they can be referred to individually, keeps magic item charges in
+// range and totals what each container holds
+func (e *Engine) applyInventory(character *models.Character) {
+	if character.Inventory == nil {
+		return
+	}
+
+	for i := range character.Inventory.Items {
+		item := &character.Inventory.Items[i]
+		if item.ID == "" {
+			item.ID = NewItemID()
+		}
+		item.Item = ItemStats(e.catalog, item)
+		applyItemCharges(item)
+	}
+	e.applyContainers(character)
+}
+
+// NewItemID returns a random identifier for an inventory item
+func NewItemID() string {
+	var buf [12]byte
+	// crypto/rand.Read never returns an error
+	_, _ = rand.Read(buf[:])
+	return hex.EncodeToString(buf[:])
+}
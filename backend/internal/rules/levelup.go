@@ -0,0 +1,380 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/reference"
+)
+
+// MaxLevel is the highest character level
+const MaxLevel = 20
+
+// featSource is the feature source recorded for feats taken at level-up
+const featSource = "Feat"
+
+// LevelUpOptions describes the next level in a class: the features it grants
+// and the choices it requires. Multiclass prerequisites are checked when the
+// class is new to the character.
+func (e *Engine) LevelUpOptions(character *models.Character, className string) (*models.LevelUpOptions, error) {
+	if e.catalog == nil {
+		return nil, errors.New("levelling up requires the reference catalog")
+	}
+	if character.Level >= MaxLevel {
+		return nil, fmt.Errorf("character is already level %d", MaxLevel)
+	}
+
+	class, ok := e.catalog.Class(className)
+	if !ok {
+		return nil, fmt.Errorf("class %q is not in the reference catalog", className)
+	}
+
+	current := e.classLevel(character, class.ID)
+	next := current + 1
+	options := &models.LevelUpOptions{
+		Class:                   class.Name,
+		ClassLevel:              next,
+		Level:                   character.Level + 1,
+		Multiclassing:           current == 0,
+		HitDie:                  class.HitDie,
+		Features:                slices.Clone(class.Features[next]),
+		AbilityScoreImprovement: AbilityScoreImprovements(class.ID, next) > AbilityScoreImprovements(class.ID, current),
+	}
+
+	if options.Multiclassing {
+		if err := e.checkMulticlassPrerequisites(character, class); err != nil {
+			return nil, err
+		}
+	}
+
+	subclass := e.chosenSubclass(character, class.ID)
+	switch {
+	case subclass != nil:
+		options.Features = append(options.Features, subclass.Features[next]...)
+	case next >= class.SubclassLevel:
+		options.SubclassRequired = true
+		for _, id := range class.Subclasses {
+			if entry, ok := e.catalog.Subclass(id); ok {
+				options.Subclasses = append(options.Subclasses, entry.Name)
+			}
+		}
+	}
+
+	if sc := class.Spellcasting; sc != nil {
+		options.Cantrips = knownAt(sc.CantripsKnown, next) - knownAt(sc.CantripsKnown, current)
+		options.Spells = knownAt(sc.SpellsKnown, next) - knownAt(sc.SpellsKnown, current)
+		options.MaxSpellLevel = MaxSpellLevel(sc.Progression, next)
+	}
+
+	return options, nil
+}
+
+// LevelUp advances the character by one level in the requested class, applying
+// the hit point increase, new features and every choice the level requires.
+// roll returns a hit die result for characters using rolled hit point growth.
+func (e *Engine) LevelUp(character *models.Character, request *models.LevelUpRequest, roll func(hitDie int) int) (*models.LevelUpResult, error) {
+	e.Apply(character)
+
+	options, err := e.LevelUpOptions(character, request.Class)
+	if err != nil {
+		return nil, err
+	}
+	class, _ := e.catalog.Class(request.Class)
+
+	subclass, problems := e.checkLevelUpChoices(character, class, options, request)
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("level up errors: %v", problems)
+	}
+
+	previousMaximum := character.HitPoints.Maximum
+	character.Level = options.Level
+	e.advanceClass(character, class, subclass)
+
+	for _, increase := range request.AbilityScoreIncreases {
+		e.applyLevelUpIncrease(character, increase)
+	}
+
+	var gained []models.Feature
+	if request.Feat != "" {
+		feat := models.Feature{Name: request.Feat, Source: featSource}
+		if entry, ok := e.catalog.Feat(request.Feat); ok {
+			feat.Name, feat.Description = entry.Name, entry.Description
+		}
+		gained = append(gained, feat)
+	}
+	if subclass != nil && options.SubclassRequired {
+		options.Features = append(options.Features, subclass.Features[options.ClassLevel]...)
+	}
+	for _, name := range options.Features {
+		source := fmt.Sprintf("%s %d", class.Name, options.ClassLevel)
+		if subclass != nil && slices.Contains(subclass.Features[options.ClassLevel], name) {
+			source = fmt.Sprintf("%s %d", subclass.Name, options.ClassLevel)
+		}
+		gained = append(gained, models.Feature{Name: name, Source: source})
+	}
+	character.Features = append(character.Features, gained...)
+
+	e.learnSpells(character, request)
+
+	hitPoints := models.HitPointLevel{
+		Class:  class.Name,
+		HitDie: class.HitDie,
+		Roll:   AverageHitDie(class.HitDie),
+		Method: HitPointAverage,
+	}
+	if character.HitPoints.Growth == GrowthRolled && roll != nil {
+		hitPoints.Roll = roll(class.HitDie)
+		hitPoints.Method = HitPointRolled
+	}
+	character.HitPoints.History = append(character.HitPoints.History, hitPoints)
+
+	e.Apply(character)
+
+	increase := character.HitPoints.Maximum - previousMaximum
+	character.HitPoints.Current = min(character.HitPoints.Current+max(increase, 0), character.HitPoints.Maximum)
+	if n := len(character.HitPoints.History); n > 0 {
+		hitPoints = character.HitPoints.History[n-1]
+	}
+
+	return &models.LevelUpResult{
+		Character:        character,
+		Options:          *options,
+		HitPoints:        hitPoints,
+		HitPointIncrease: increase,
+		Features:         gained,
+	}, nil
+}
+
+// MaxSpellLevel returns the highest level of spell a single-classed caster of
+// the given progression can learn at a class level
+func MaxSpellLevel(progression string, classLevel int) int {
+	if progression == ProgressionPact {
+		_, slotLevel := PactMagicSlots(classLevel)
+		return slotLevel
+	}
+
+	slots := SpellSlotsForCasterLevel(CasterLevel([]ClassLevel{{Level: classLevel}}, []string{progression}))
+	for i := len(slots) - 1; i >= 0; i-- {
+		if slots[i] > 0 {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// checkMulticlassPrerequisites requires the character to meet the
+// prerequisites of both the new class and every class it already has
+func (e *Engine) checkMulticlassPrerequisites(character *models.Character, class *reference.Class) error {
+	scores := &character.AbilityScores
+	if !MeetsMulticlassPrerequisites(scores, class.MulticlassPrerequisites) {
+		return fmt.Errorf("%s requires %s to multiclass", class.Name, DescribeMulticlassPrerequisites(class.MulticlassPrerequisites))
+	}
+
+	for _, cl := range ClassLevels(character) {
+		existing, ok := e.catalog.Class(cl.Class)
+		if ok && !MeetsMulticlassPrerequisites(scores, existing.MulticlassPrerequisites) {
+			return fmt.Errorf("%s requires %s to multiclass", existing.Name, DescribeMulticlassPrerequisites(existing.MulticlassPrerequisites))
+		}
+	}
+
+	return nil
+}
+
+// checkLevelUpChoices validates the request against the options for the level
+// and returns the subclass being used at the new level
+func (e *Engine) checkLevelUpChoices(character *models.Character, class *reference.Class, options *models.LevelUpOptions, request *models.LevelUpRequest) (*reference.Subclass, []string) {
+	var problems []string
+
+	subclass := e.chosenSubclass(character, class.ID)
+	switch {
+	case options.SubclassRequired && request.Subclass == "":
+		problems = append(problems, fmt.Sprintf("a subclass must be chosen at %s level %d", class.Name, options.ClassLevel))
+	case options.SubclassRequired:
+		entry, ok := e.catalog.Subclass(request.Subclass)
+		if !ok || entry.Class != class.ID {
+			problems = append(problems, fmt.Sprintf("subclass %q does not belong to class %q", request.Subclass, class.Name))
+		}
+		subclass = entry
+	case request.Subclass != "":
+		problems = append(problems, fmt.Sprintf("a subclass cannot be chosen at %s level %d", class.Name, options.ClassLevel))
+	}
+
+	total := 0
+	raised := map[string]bool{}
+	for i, increase := range request.AbilityScoreIncreases {
+		ability := CanonicalAbility(increase.Ability)
+		switch {
+		case ability == "":
+			problems = append(problems, fmt.Sprintf("abilityScoreIncreases[%d].ability %q is not an ability", i, increase.Ability))
+		case raised[ability]:
+			problems = append(problems, fmt.Sprintf("abilityScoreIncreases[%d].ability %q is already raised", i, increase.Ability))
+		}
+		raised[ability] = true
+		if increase.Amount < 1 || increase.Amount > 2 {
+			problems = append(problems, fmt.Sprintf("abilityScoreIncreases[%d].amount must be 1 or 2", i))
+		}
+		total += increase.Amount
+	}
+	switch {
+	case !options.AbilityScoreImprovement && (total > 0 || request.Feat != ""):
+		problems = append(problems, fmt.Sprintf("%s level %d does not grant an Ability Score Improvement", class.Name, options.ClassLevel))
+	case options.AbilityScoreImprovement && total > 0 && request.Feat != "":
+		problems = append(problems, "choose either ability score increases or a feat, not both")
+	case options.AbilityScoreImprovement && request.Feat == "" && total != 2:
+		problems = append(problems, "an Ability Score Improvement requires increases totalling 2 or a feat")
+	}
+
+	problems = append(problems, e.checkSpellPicks(character, class, options, request)...)
+
+	return subclass, problems
+}
+
+// checkSpellPicks requires exactly the number of new cantrips and spells the
+// level grants, each on the class spell list and of a level the class can cast
+func (e *Engine) checkSpellPicks(character *models.Character, class *reference.Class, options *models.LevelUpOptions, request *models.LevelUpRequest) []string {
+	var problems []string
+
+	if len(request.Cantrips) != options.Cantrips {
+		problems = append(problems, fmt.Sprintf("%s level %d grants %d new cantrips, got %d", class.Name, options.ClassLevel, options.Cantrips, len(request.Cantrips)))
+	}
+	if len(request.Spells) != options.Spells {
+		problems = append(problems, fmt.Sprintf("%s level %d grants %d new spells, got %d", class.Name, options.ClassLevel, options.Spells, len(request.Spells)))
+	}
+
+	var known []string
+	if sc := character.Spellcasting; sc != nil {
+		known = append(append(slices.Clone(sc.CantripsKnown), sc.SpellsKnown...), sc.PreparedSpells...)
+	}
+
+	check := func(field string, names []string, cantrips bool) {
+		for i, name := range names {
+			spell, ok := e.catalog.Spell(name)
+			switch {
+			case !ok:
+				problems = append(problems, fmt.Sprintf("%s[%d] %q is not in the reference catalog", field, i, name))
+			case !slices.Contains(spell.Classes, class.ID):
+				problems = append(problems, fmt.Sprintf("%s[%d] %q is not on the spell list for %s", field, i, name, class.Name))
+			case cantrips && spell.Level != 0:
+				problems = append(problems, fmt.Sprintf("%s[%d] %q is not a cantrip", field, i, name))
+			case !cantrips && (spell.Level == 0 || spell.Level > options.MaxSpellLevel):
+				problems = append(problems, fmt.Sprintf("%s[%d] %q is not a spell of level 1 to %d", field, i, name, options.MaxSpellLevel))
+			case containsFold(known, spell.Name):
+				problems = append(problems, fmt.Sprintf("%s[%d] %q is already known", field, i, name))
+			}
+		}
+	}
+	check("cantrips", request.Cantrips, true)
+	check("spells", request.Spells, false)
+
+	return problems
+}
+
+// advanceClass adds one level to the class, adding a multiclass entry for a
+// new class and recording a newly chosen subclass
+func (e *Engine) advanceClass(character *models.Character, class *reference.Class, subclass *reference.Subclass) {
+	subclassName := ""
+	if subclass != nil {
+		subclassName = subclass.Name
+	}
+
+	if e.classID(character.Class) == class.ID {
+		character.ClassLevel++
+		if character.Subclass == "" {
+			character.Subclass = subclassName
+		}
+		return
+	}
+
+	for i := range character.Multiclass {
+		entry := &character.Multiclass[i]
+		if e.classID(entry.Class) == class.ID {
+			entry.Level++
+			if entry.Subclass == "" {
+				entry.Subclass = subclassName
+			}
+			return
+		}
+	}
+
+	character.Multiclass = append(character.Multiclass, models.MulticlassEntry{
+		Class:    class.Name,
+		Subclass: subclassName,
+		Level:    1,
+	})
+}
+
+// applyLevelUpIncrease records an Ability Score Improvement. Characters with
+// base scores keep it as a level-up increase; others have the score raised
+// directly.
+func (e *Engine) applyLevelUpIncrease(character *models.Character, choice models.LevelUpIncrease) {
+	scores := &character.AbilityScores
+	increase := models.AbilityScoreIncrease{
+		Ability: CanonicalAbility(choice.Ability),
+		Amount:  choice.Amount,
+		Source:  IncreaseLevelUp,
+		Level:   character.Level,
+	}
+
+	if HasBaseScores(scores) {
+		scores.Increases = append(scores.Increases, increase)
+		return
+	}
+
+	score := AbilityByName(scores, increase.Ability)
+	score.Score = min(score.Score+increase.Amount, max(score.Score, AbilityScoreCap))
+}
+
+// learnSpells adds the picked cantrips and spells to the character's spell
+// lists
+func (e *Engine) learnSpells(character *models.Character, request *models.LevelUpRequest) {
+	if len(request.Cantrips) == 0 && len(request.Spells) == 0 {
+		return
+	}
+	if character.Spellcasting == nil {
+		character.Spellcasting = &models.Spellcasting{}
+	}
+
+	for _, name := range request.Cantrips {
+		spell, _ := e.catalog.Spell(name)
+		character.Spellcasting.CantripsKnown = append(character.Spellcasting.CantripsKnown, spell.Name)
+	}
+	for _, name := range request.Spells {
+		spell, _ := e.catalog.Spell(name)
+		character.Spellcasting.SpellsKnown = append(character.Spellcasting.SpellsKnown, spell.Name)
+	}
+}
+
+// chosenSubclass returns the subclass the character has chosen for a class,
+// or nil when none is chosen or it is missing from the catalog
+func (e *Engine) chosenSubclass(character *models.Character, classID string) *reference.Subclass {
+	for _, cl := range ClassLevels(character) {
+		if e.classID(cl.Class) != classID || cl.Subclass == "" {
+			continue
+		}
+		if subclass, ok := e.catalog.Subclass(cl.Subclass); ok {
+			return subclass
+		}
+	}
+	return nil
+}
+
+// knownAt returns the value of a per-level progression at a class level, or 0
+// when the class has no levels or no progression
+func knownAt(progression []int, classLevel int) int {
+	if classLevel < 1 || classLevel > len(progression) {
+		return 0
+	}
+	return progression[classLevel-1]
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,306 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/yourusername/dnd-character-creator/internal/dice"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/reference"
+)
+
+// Stats a magic item modifier can change besides ability scores
+const (
+	ModifierArmorClass   = "armorClass"
+	ModifierSavingThrows = "savingThrows"
+	ModifierSpellAttack  = "spellAttack"
+	ModifierSpellSaveDC  = "spellSaveDC"
+	ModifierAttack       = "attack"
+	ModifierDamage       = "damage"
+	ModifierInitiative   = "initiative"
+	ModifierSpeed        = "speed"
+)
+
+// How a modifier changes its target. Only ability scores can be set.
+const (
+	ModifierAdd = "add"
+	ModifierSet = "set"
+)
+
+// MaxAbilityScore is the highest an ability score can be, even with magic
+const MaxAbilityScore = 30
+
+// modifierTargets are the targets other than ability scores
+var modifierTargets = []string{
+	ModifierArmorClass, ModifierSavingThrows, ModifierSpellAttack, ModifierSpellSaveDC,
+	ModifierAttack, ModifierDamage, ModifierInitiative, ModifierSpeed,
+}
+
+// IsModifierTarget reports whether a magic item modifier can change target
+func IsModifierTarget(target string) bool {
+	return slices.Contains(modifierTargets, target) || IsAbility(target)
+}
+
+// IsAbility reports whether name is one of the six abilities, by full or
+// abbreviated name
+func IsAbility(name string) bool {
+	return slices.Contains(Abilities, CanonicalAbility(name))
+}
+
+// RequiresAttunement reports whether an item only works while attuned
+func RequiresAttunement(stats *models.Item) bool {
+	return stats != nil && stats.Magic != nil && stats.Magic.RequiresAttunement
+}
+
+// itemCharges returns how many charges an item holds, or nil for items
+// without charges
+func itemCharges(stats *models.Item) *models.ItemCharges {
+	if stats == nil || stats.Magic == nil {
+		return nil
+	}
+	return stats.Magic.Charges
+}
+
+// activeModifier is a modifier from a magic item that is currently working
+type activeModifier struct {
+	item     *models.InventoryItem
+	modifier models.ItemModifier
+}
+
+// activeModifiers returns the modifiers of magic items that are equipped and,
+// when they require it, attuned
+func activeModifiers(catalog *reference.Catalog, inventory *models.Inventory) []activeModifier {
+	if inventory == nil {
+		return nil
+	}
+
+	var modifiers []activeModifier
+	for i := range inventory.Items {
+		item := &inventory.Items[i]
+		stats := ItemStats(catalog, item)
+		if !item.Equipped || stats == nil || stats.Magic == nil {
+			continue
+		}
+		if stats.Magic.RequiresAttunement && !item.Attuned {
+			continue
+		}
+		for _, modifier := range stats.Magic.Modifiers {
+			modifiers = append(modifiers, activeModifier{item: item, modifier: modifier})
+		}
+	}
+	return modifiers
+}
+
+// modifierBonus sums the modifiers that add to target, limited to a single
+// item when item is not nil
+func modifierBonus(modifiers []activeModifier, target string, item *models.InventoryItem) int {
+	total := 0
+	for _, active := range modifiers {
+		if active.modifier.Target != target || active.modifier.Operation == ModifierSet {
+			continue
+		}
+		if item != nil && active.item != item {
+			continue
+		}
+		total += active.modifier.Value
+	}
+	return total
+}
+
+// applyItemAbilityScores raises ability scores with magic items, adding
+// bonuses before setting scores so an item that sets Strength to 19 doesn't
+// stack with one that adds to it. Scores only derive from their base values;
+// raising the stored scores of characters without them would outlast the
+// item, so those modifiers are reported by SkippedAbilityModifiers instead.
+func (e *Engine) applyItemAbilityScores(character *models.Character) {
+	scores := &character.AbilityScores
+	if !HasBaseScores(scores) {
+		return
+	}
+
+	modifiers := activeModifiers(e.catalog, character.Inventory)
+	for _, operation := range []string{ModifierAdd, ModifierSet} {
+		for _, active := range modifiers {
+			modifier := active.modifier
+			score := AbilityByName(scores, modifier.Target)
+			if score == nil || (modifier.Operation == ModifierSet) != (operation == ModifierSet) {
+				continue
+			}
+			if operation == ModifierSet {
+				score.Score = max(score.Score, modifier.Value)
+			} else {
+				score.Score += modifier.Value
+			}
+			score.Score = clamp(score.Score, 1, MaxAbilityScore)
+		}
+	}
+}
+
+// SkippedAbilityModifiers describes the active magic item modifiers to ability
+// scores that are not applied because the character has no base scores
+func SkippedAbilityModifiers(catalog *reference.Catalog, character *models.Character) []string {
+	if HasBaseScores(&character.AbilityScores) {
+		return nil
+	}
+
+	var skipped []string
+	for _, active := range activeModifiers(catalog, character.Inventory) {
+		if ability := CanonicalAbility(active.modifier.Target); ability != "" {
+			skipped = append(skipped, fmt.Sprintf("%s does not change %s without base ability scores", ItemName(active.item), ability))
+		}
+	}
+	return skipped
+}
+
+// applyItemModifiers adds the bonuses from magic items to armor class, saving
+// throws, initiative and walking speed
+func (e *Engine) applyItemModifiers(character *models.Character) {
+	modifiers := activeModifiers(e.catalog, character.Inventory)
+	if len(modifiers) == 0 {
+		return
+	}
+
+	for _, active := range modifiers {
+		if active.modifier.Target == ModifierArmorClass && active.modifier.Operation != ModifierSet {
+			character.ArmorClassBreakdown = appendComponent(character.ArmorClassBreakdown, ItemName(active.item), active.modifier.Value)
+		}
+	}
+	character.ArmorClass = sumComponents(character.ArmorClassBreakdown)
+
+	if bonus := modifierBonus(modifiers, ModifierSavingThrows, nil); bonus != 0 {
+		saves := &character.SavingThrowBonuses
+		for _, save := range []*int{&saves.Strength, &saves.Dexterity, &saves.Constitution, &saves.Intelligence, &saves.Wisdom, &saves.Charisma} {
+			*save += bonus
+		}
+	}
+
+	character.Initiative += modifierBonus(modifiers, ModifierInitiative, nil)
+
+	if speed := character.EffectiveSpeed; speed != nil && speed.Walk > 0 {
+		speed.Walk = max(speed.Walk+modifierBonus(modifiers, ModifierSpeed, nil), 0)
+	}
+}
+
+// checkAttunementPrerequisites reports why the character can't attune to an
+// item, if it can't
+func (e *Engine) checkAttunementPrerequisites(character *models.Character, name string, stats *models.Item) error {
+	if stats.Magic == nil || stats.Magic.Prerequisites == nil {
+		return nil
+	}
+	prerequisites := stats.Magic.Prerequisites
+
+	if len(prerequisites.Classes) > 0 && !slices.ContainsFunc(prerequisites.Classes, func(class string) bool {
+		return e.classLevel(character, e.classID(class)) > 0
+	}) {
+		return fmt.Errorf("%s requires attunement by a %s", name, strings.Join(prerequisites.Classes, " or "))
+	}
+
+	if len(prerequisites.Races) > 0 && !slices.ContainsFunc(prerequisites.Races, func(race string) bool {
+		return e.raceMatches(character, race)
+	}) {
+		return fmt.Errorf("%s requires attunement by a %s", name, strings.Join(prerequisites.Races, " or "))
+	}
+
+	if prerequisites.Spellcaster && len(e.spellcastingClasses(character)) == 0 {
+		return fmt.Errorf("%s requires attunement by a spellcaster", name)
+	}
+
+	return nil
+}
+
+// raceMatches reports whether the character's race or subrace is race
+func (e *Engine) raceMatches(character *models.Character, race string) bool {
+	if strings.EqualFold(character.Race, race) || strings.EqualFold(character.Subrace, race) {
+		return true
+	}
+	if e.catalog == nil {
+		return false
+	}
+	own, ok := e.catalog.Race(character.Race)
+	other, found := e.catalog.Race(race)
+	return ok && found && own.ID == other.ID
+}
+
+// SpendCharges uses amount charges of a magic item, one when amount is 0. An
+// item that requires attunement must be attuned to use its charges.
+func (e *Engine) SpendCharges(character *models.Character, id string, amount int) error {
+	e.Apply(character)
+
+	item := findInventoryItem(character, id)
+	if item == nil {
+		return fmt.Errorf("character has no item %q", id)
+	}
+	name := ItemName(item)
+	if itemCharges(item.Item) == nil || item.Charges == nil {
+		return fmt.Errorf("%s has no charges", name)
+	}
+	if RequiresAttunement(item.Item) && !item.Attuned {
+		return fmt.Errorf("%s must be attuned to use its charges", name)
+	}
+
+	amount = max(amount, 1)
+	if amount > *item.Charges {
+		return fmt.Errorf("only %d charges of %s remain", *item.Charges, name)
+	}
+	*item.Charges -= amount
+	return nil
+}
+
+// Dawn restores resources and magic item charges that recharge at dawn. Items
+// that regain a rolled number of charges roll with roll.
+func (e *Engine) Dawn(character *models.Character, roll func(int) int) *models.RestResult {
+	e.Apply(character)
+
+	return &models.RestResult{
+		Character:         character,
+		ResourcesRestored: restoreResources(character, RechargeDawn),
+		ItemsRecharged:    rechargeItems(character, roll, RechargeDawn),
+	}
+}
+
+// rechargeItems restores the charges of magic items with one of the given
+// recharges and returns the names of those that regained any. Items with
+// Regain dice regain their roll; the rest regain every charge.
+func rechargeItems(character *models.Character, roll func(int) int, recharges ...string) []string {
+	if character.Inventory == nil {
+		return nil
+	}
+
+	var recharged []string
+	for i := range character.Inventory.Items {
+		item := &character.Inventory.Items[i]
+		charges := itemCharges(item.Item)
+		if charges == nil || item.Charges == nil || !slices.Contains(recharges, charges.Recharge) {
+			continue
+		}
+		if *item.Charges >= charges.Maximum {
+			continue
+		}
+
+		regained := charges.Maximum
+		if charges.Regain != "" && roll != nil {
+			if result, err := dice.Roll(roll, charges.Regain, ""); err == nil {
+				regained = max(result.Total, 0)
+			}
+		}
+		*item.Charges = min(*item.Charges+regained, charges.Maximum)
+		recharged = append(recharged, ItemName(item))
+	}
+	return recharged
+}
+
+// applyItemCharges gives magic items with charges a full set when they are
+// first added and keeps their charges within the maximum
+func applyItemCharges(item *models.InventoryItem) {
+	charges := itemCharges(item.Item)
+	if charges == nil {
+		return
+	}
+	if item.Charges == nil {
+		current := charges.Maximum
+		item.Charges = &current
+		return
+	}
+	*item.Charges = clamp(*item.Charges, 0, charges.Maximum)
+}
@@ -0,0 +1,174 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/reference"
+)
+
+// When a resource regains its uses
+const (
+	RechargeShortRest = "shortRest"
+	RechargeLongRest  = "longRest"
+	RechargeDawn      = "dawn"
+)
+
+// SpendResource uses amount uses of a resource, one when amount is 0.
+// Unlimited resources can always be spent.
+func (e *Engine) SpendResource(character *models.Character, name string, amount int) error {
+	e.Apply(character)
+
+	resource := findResource(character, name)
+	if resource == nil {
+		return fmt.Errorf("character has no resource %q", name)
+	}
+	if resource.Unlimited {
+		return nil
+	}
+
+	amount = max(amount, 1)
+	if amount > resource.Current {
+		return fmt.Errorf("only %d uses of %s remain", resource.Current, resource.Name)
+	}
+	resource.Used += amount
+	resource.Current = resource.Maximum - resource.Used
+	return nil
+}
+
+// RestoreResource regains amount uses of a resource, all of them when amount
+// is 0
+func (e *Engine) RestoreResource(character *models.Character, name string, amount int) error {
+	e.Apply(character)
+
+	resource := findResource(character, name)
+	if resource == nil {
+		return fmt.Errorf("character has no resource %q", name)
+	}
+
+	if amount == 0 {
+		amount = resource.Used
+	}
+	resource.Used = max(resource.Used-amount, 0)
+	resource.Current = resource.Maximum - resource.Used
+	return nil
+}
+
+// restoreResources regains every use of the resources with one of the given
+// recharges and returns the names of those that had been used
+func restoreResources(character *models.Character, recharges ...string) []string {
+	var restored []string
+	for i := range character.Resources {
+		resource := &character.Resources[i]
+		if resource.Used == 0 || !slices.Contains(recharges, resource.Recharge) {
+			continue
+		}
+		resource.Used = 0
+		resource.Current = resource.Maximum
+		restored = append(restored, resource.Name)
+	}
+	return restored
+}
+
+// applyResources derives the maximum and recharge of each class resource from
+// the catalog, keeping the uses already spent. A resource granted by more than
+// one class, such as Channel Divinity, is a single pool using the larger
+// maximum. Resources that don't come from a class are kept as supplied.
+func (e *Engine) applyResources(character *models.Character) {
+	if e.catalog == nil {
+		for i := range character.Resources {
+			clampResource(&character.Resources[i])
+		}
+		return
+	}
+
+	var resources []models.Resource
+	for _, cl := range ClassLevels(character) {
+		class, ok := e.catalog.Class(cl.Class)
+		if !ok {
+			continue
+		}
+		for _, classResource := range class.Resources {
+			if cl.Level < classResource.Level {
+				continue
+			}
+			resource := classResourceFor(character, class, &classResource, cl.Level)
+			i := slices.IndexFunc(resources, func(r models.Resource) bool {
+				return strings.EqualFold(r.Name, resource.Name)
+			})
+			if i < 0 {
+				resources = append(resources, resource)
+			} else if resource.Maximum > resources[i].Maximum || resource.Unlimited {
+				resources[i] = resource
+			}
+		}
+	}
+
+	for _, resource := range character.Resources {
+		i := slices.IndexFunc(resources, func(r models.Resource) bool {
+			return strings.EqualFold(r.Name, resource.Name)
+		})
+		if i >= 0 {
+			resources[i].Used = resource.Used
+			continue
+		}
+		if _, fromClass := e.catalog.Class(resource.Source); !fromClass {
+			resources = append(resources, resource)
+		}
+	}
+
+	for i := range resources {
+		clampResource(&resources[i])
+	}
+	character.Resources = resources
+}
+
+// classResourceFor works out a class resource's maximum and recharge at a
+// class level
+func classResourceFor(character *models.Character, class *reference.Class, resource *reference.ClassResource, level int) models.Resource {
+	formula := resource.Maximum
+	maximum := formula.PerLevel*level + formula.Bonus
+	if len(formula.Table) > 0 {
+		maximum += formula.Table[min(level, len(formula.Table))-1]
+	}
+	if formula.Ability != "" {
+		modifier, _ := abilityModifierByName(&character.AbilityScores, formula.Ability)
+		maximum += modifier
+	}
+
+	recharge := resource.Recharge
+	if resource.ShortRestLevel > 0 && level >= resource.ShortRestLevel {
+		recharge = RechargeShortRest
+	}
+
+	return models.Resource{
+		Name:      resource.Name,
+		Source:    class.Name,
+		Maximum:   max(maximum, formula.Minimum, 0),
+		Recharge:  recharge,
+		Unlimited: formula.UnlimitedLevel > 0 && level >= formula.UnlimitedLevel,
+	}
+}
+
+// clampResource keeps the uses spent within the maximum and sets the uses
+// remaining
+func clampResource(resource *models.Resource) {
+	if resource.Unlimited {
+		resource.Used = 0
+	}
+	resource.Used = clamp(resource.Used, 0, resource.Maximum)
+	resource.Current = resource.Maximum - resource.Used
+}
+
+// findResource returns the character's resource with the given name
+func findResource(character *models.Character, name string) *models.Resource {
+	name = strings.TrimSpace(name)
+	for i := range character.Resources {
+		if strings.EqualFold(character.Resources[i].Name, name) {
+			return &character.Resources[i]
+		}
+	}
+	return nil
+}
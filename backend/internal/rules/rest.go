@@ -0,0 +1,188 @@
+package rules
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/yourusername/dnd-character-creator/internal/models"
+)
+
+// Ways to resolve a hit die spent on a short rest
+const (
+	HitDieRolled  = "rolled"
+	HitDieAverage = "average"
+)
+
+// ShortRest spends the requested hit dice and restores Pact Magic slots,
+// resources and magic item charges that recharge on a short rest. Dice are
+// rolled with roll unless the request asks for the average or roll is nil.
+func (e *Engine) ShortRest(character *models.Character, request *models.ShortRestRequest, roll func(int) int) (*models.RestResult, error) {
+	e.Apply(character)
+
+	if character.Status == StatusDead {
+		return nil, errors.New("character is dead")
+	}
+	if character.HitPoints.Current < 1 {
+		return nil, errors.New("a character must have at least 1 hit point to benefit from a short rest")
+	}
+
+	spends := make([]*models.HitDice, len(request.HitDice))
+	for i, spend := range request.HitDice {
+		pool := e.hitDicePool(character, spend.Class)
+		if pool == nil {
+			return nil, fmt.Errorf("hitDice[%d] %q is not one of the character's classes", i, spend.Class)
+		}
+		if remaining := pool.Total - pool.Spent; spend.Count > remaining {
+			return nil, fmt.Errorf("hitDice[%d] only %d %s hit dice remain", i, remaining, pool.Class)
+		}
+		pool.Spent += spend.Count
+		spends[i] = pool
+	}
+
+	result := &models.RestResult{Character: character}
+	hp := &character.HitPoints
+	conModifier := character.AbilityScores.Constitution.Modifier
+	for i, spend := range request.HitDice {
+		pool := spends[i]
+		for range spend.Count {
+			value := AverageHitDie(pool.Die)
+			if request.Method != HitDieAverage && roll != nil {
+				value = roll(pool.Die)
+			}
+			healing := max(value+conModifier, 0)
+			result.Rolls = append(result.Rolls, models.HitDieRoll{Class: pool.Class, Die: pool.Die, Roll: value, Healing: healing})
+
+			regained := min(healing, hp.Maximum-hp.Current)
+			hp.Current += regained
+			result.HitPointsRegained += regained
+		}
+	}
+
+	if pact := pactMagic(character); pact != nil {
+		result.SpellSlotsRestored += pact.Used
+		pact.Used = 0
+	}
+	result.ResourcesRestored = restoreResources(character, RechargeShortRest)
+	result.ItemsRecharged = rechargeItems(character, nil, RechargeShortRest)
+
+	return result, nil
+}
+
+// LongRest restores all hit points, spell slots, resources and magic item
+// charges that recharge on a rest and up to half the character's hit dice,
+// clears temporary hit points and death saves, and removes a level of
+// exhaustion
+func (e *Engine) LongRest(character *models.Character) (*models.RestResult, error) {
+	if character.HitPoints.Current < 1 {
+		return nil, errors.New("a character must have at least 1 hit point to benefit from a long rest")
+	}
+
+	// Removing exhaustion first lets a level 4 character rest back to its
+	// full hit point maximum
+	character.Exhaustion = max(character.Exhaustion-1, 0)
+	e.Apply(character)
+
+	result := &models.RestResult{Character: character}
+	hp := &character.HitPoints
+	result.HitPointsRegained = hp.Maximum - hp.Current
+	hp.Current = hp.Maximum
+	hp.Temporary = 0
+
+	if character.DeathSaves != nil {
+		*character.DeathSaves = models.DeathSaves{}
+	}
+
+	if character.Spellcasting != nil && character.Spellcasting.SpellSlots != nil {
+		for _, slot := range SpellSlotLevels(character.Spellcasting.SpellSlots) {
+			result.SpellSlotsRestored += slot.Used
+			slot.Used = 0
+		}
+	}
+	if pact := pactMagic(character); pact != nil {
+		result.SpellSlotsRestored += pact.Used
+		pact.Used = 0
+	}
+
+	result.HitDiceRecovered = recoverHitDice(character.HitDice)
+	result.ResourcesRestored = restoreResources(character, RechargeShortRest, RechargeLongRest)
+	result.ItemsRecharged = rechargeItems(character, nil, RechargeShortRest, RechargeLongRest)
+
+	return result, nil
+}
+
+// recoverHitDice regains spent hit dice up to half the character's total,
+// minimum one, starting with the largest dice
+func recoverHitDice(pools []models.HitDice) int {
+	total := 0
+	for _, pool := range pools {
+		total += pool.Total
+	}
+	budget := max(total/2, 1)
+
+	order := make([]*models.HitDice, len(pools))
+	for i := range pools {
+		order[i] = &pools[i]
+	}
+	slices.SortStableFunc(order, func(a, b *models.HitDice) int {
+		return cmp.Compare(b.Die, a.Die)
+	})
+
+	recovered := 0
+	for _, pool := range order {
+		regained := min(pool.Spent, budget-recovered)
+		pool.Spent -= regained
+		recovered += regained
+	}
+	return recovered
+}
+
+// applyHitDice builds a hit dice pool for each of the character's classes,
+// keeping the dice already spent. Characters with classes missing from the
+// catalog keep their client-supplied pools.
+func (e *Engine) applyHitDice(character *models.Character) {
+	if e.catalog == nil {
+		return
+	}
+
+	spent := make(map[string]int)
+	for _, pool := range character.HitDice {
+		spent[e.classID(pool.Class)] += pool.Spent
+	}
+
+	var pools []models.HitDice
+	for _, cl := range ClassLevels(character) {
+		class, ok := e.catalog.Class(cl.Class)
+		if !ok {
+			return
+		}
+		pools = append(pools, models.HitDice{
+			Class: class.Name,
+			Die:   class.HitDie,
+			Total: cl.Level,
+			Spent: clamp(spent[class.ID], 0, cl.Level),
+		})
+	}
+
+	character.HitDice = pools
+}
+
+// hitDicePool returns the character's hit dice pool for a class
+func (e *Engine) hitDicePool(character *models.Character, class string) *models.HitDice {
+	id := e.classID(class)
+	for i := range character.HitDice {
+		if e.classID(character.HitDice[i].Class) == id {
+			return &character.HitDice[i]
+		}
+	}
+	return nil
+}
+
+// pactMagic returns the character's Pact Magic slots, if any
+func pactMagic(character *models.Character) *models.PactMagic {
+	if character.Spellcasting == nil {
+		return nil
+	}
+	return character.Spellcasting.PactMagic
+}
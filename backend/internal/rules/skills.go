@@ -0,0 +1,50 @@
+package rules
+
+import (
+	"github.com/yourusername/dnd-character-creator/internal/models"
+)
+
+// SkillModifier calculates a skill modifier from its governing ability
+// modifier; expertise doubles the proficiency bonus and implies proficiency
+func SkillModifier(abilityModifier, proficiencyBonus int, skill models.Skill) int {
+	switch {
+	case skill.Expertise:
+		return abilityModifier + 2*proficiencyBonus
+	case skill.Proficient:
+		return abilityModifier + proficiencyBonus
+	default:
+		return abilityModifier
+	}
+}
+
+// applySkills sets the modifier for all 18 skills
+func applySkills(character *models.Character) {
+	scores := &character.AbilityScores
+	skills := &character.Skills
+	pb := character.ProficiencyBonus
+
+	str := scores.Strength.Modifier
+	dex := scores.Dexterity.Modifier
+	intel := scores.Intelligence.Modifier
+	wis := scores.Wisdom.Modifier
+	cha := scores.Charisma.Modifier
+
+	skills.Acrobatics.Modifier = SkillModifier(dex, pb, skills.Acrobatics)
+	skills.AnimalHandling.Modifier = SkillModifier(wis, pb, skills.AnimalHandling)
+	skills.Arcana.Modifier = SkillModifier(intel, pb, skills.Arcana)
+	skills.Athletics.Modifier = SkillModifier(str, pb, skills.Athletics)
+	skills.Deception.Modifier = SkillModifier(cha, pb, skills.Deception)
+	skills.History.Modifier = SkillModifier(intel, pb, skills.History)
+	skills.Insight.Modifier = SkillModifier(wis, pb, skills.Insight)
+	skills.Intimidation.Modifier = SkillModifier(cha, pb, skills.Intimidation)
+	skills.Investigation.Modifier = SkillModifier(intel, pb, skills.Investigation)
+	skills.Medicine.Modifier = SkillModifier(wis, pb, skills.Medicine)
+	skills.Nature.Modifier = SkillModifier(intel, pb, skills.Nature)
+	skills.Perception.Modifier = SkillModifier(wis, pb, skills.Perception)
+	skills.Performance.Modifier = SkillModifier(cha, pb, skills.Performance)
+	skills.Persuasion.Modifier = SkillModifier(cha, pb, skills.Persuasion)
+	skills.Religion.Modifier = SkillModifier(intel, pb, skills.Religion)
+	skills.SleightOfHand.Modifier = SkillModifier(dex, pb, skills.SleightOfHand)
+	skills.Stealth.Modifier = SkillModifier(dex, pb, skills.Stealth)
+	skills.Survival.Modifier = SkillModifier(wis, pb, skills.Survival)
+}
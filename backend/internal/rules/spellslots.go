@@ -0,0 +1,212 @@
+package rules
+
+import (
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/reference"
+)
+
+// Spellcasting progressions used by the reference catalog
+const (
+	ProgressionFull  = "full"
+	ProgressionHalf  = "half"
+	ProgressionThird = "third"
+	ProgressionPact  = "pact"
+)
+
+// spellSlotTable is the PHB multiclass spellcaster table, indexed by caster
+// level and then spell level
+var spellSlotTable = [21][9]int{
+	{},
+	{2},
+	{3},
+	{4, 2},
+	{4, 3},
+	{4, 3, 2},
+	{4, 3, 3},
+	{4, 3, 3, 1},
+	{4, 3, 3, 2},
+	{4, 3, 3, 3, 1},
+	{4, 3, 3, 3, 2},
+	{4, 3, 3, 3, 2, 1},
+	{4, 3, 3, 3, 2, 1},
+	{4, 3, 3, 3, 2, 1, 1},
+	{4, 3, 3, 3, 2, 1, 1},
+	{4, 3, 3, 3, 2, 1, 1, 1},
+	{4, 3, 3, 3, 2, 1, 1, 1},
+	{4, 3, 3, 3, 2, 1, 1, 1, 1},
+	{4, 3, 3, 3, 3, 1, 1, 1, 1},
+	{4, 3, 3, 3, 3, 2, 1, 1, 1},
+	{4, 3, 3, 3, 3, 2, 2, 1, 1},
+}
+
+// SpellSlotsForCasterLevel returns the number of slots per spell level for a
+// combined spellcaster level
+func SpellSlotsForCasterLevel(casterLevel int) [9]int {
+	if casterLevel < 0 {
+		casterLevel = 0
+	}
+	if casterLevel > 20 {
+		casterLevel = 20
+	}
+	return spellSlotTable[casterLevel]
+}
+
+// PactMagicSlots returns the Warlock Pact Magic slot count and slot level
+func PactMagicSlots(warlockLevel int) (slots int, slotLevel int) {
+	switch {
+	case warlockLevel < 1:
+		return 0, 0
+	case warlockLevel == 1:
+		return 1, 1
+	case warlockLevel == 2:
+		return 2, 1
+	case warlockLevel <= 10:
+		return 2, (warlockLevel + 1) / 2
+	case warlockLevel <= 16:
+		return 3, 5
+	default:
+		return 4, 5
+	}
+}
+
+// CasterLevel combines class levels into a spellcaster level. A single
+// spellcasting class uses its own table, rounding half and third casters up
+// once they gain the feature; multiple spellcasting classes use the PHB
+// multiclass rule of rounding each down. Pact Magic never contributes.
+func CasterLevel(levels []ClassLevel, progressions []string) int {
+	casters := 0
+	for _, progression := range progressions {
+		if progression == ProgressionFull || progression == ProgressionHalf || progression == ProgressionThird {
+			casters++
+		}
+	}
+
+	total := 0
+	for i, cl := range levels {
+		switch progressions[i] {
+		case ProgressionFull:
+			total += cl.Level
+		case ProgressionHalf:
+			if casters == 1 {
+				if cl.Level >= 2 {
+					total += (cl.Level + 1) / 2
+				}
+			} else {
+				total += cl.Level / 2
+			}
+		case ProgressionThird:
+			if casters == 1 {
+				if cl.Level >= 3 {
+					total += (cl.Level + 2) / 3
+				}
+			} else {
+				total += cl.Level / 3
+			}
+		}
+	}
+
+	return total
+}
+
+// applySpellSlots computes spell slots and Pact Magic from the catalog's class
+// progressions. Used counts are kept and clamped to the new totals. Characters
+// with classes missing from the catalog keep their client-supplied slots.
+func (e *Engine) applySpellSlots(character *models.Character) {
+	if e.catalog == nil {
+		return
+	}
+
+	levels := ClassLevels(character)
+	progressions := make([]string, len(levels))
+	warlockLevel := 0
+	ability := ""
+
+	for i, cl := range levels {
+		class, ok := e.catalog.Class(cl.Class)
+		if !ok {
+			return
+		}
+		progressions[i] = spellcastingProgression(e.catalog, class, cl.Subclass)
+		if ability == "" && class.Spellcasting != nil {
+			ability = class.Spellcasting.Ability
+		}
+		if progressions[i] == ProgressionPact {
+			warlockLevel += cl.Level
+		}
+	}
+
+	casterLevel := CasterLevel(levels, progressions)
+	if casterLevel == 0 && warlockLevel == 0 {
+		if character.Spellcasting != nil {
+			character.Spellcasting.SpellSlots = nil
+			character.Spellcasting.PactMagic = nil
+		}
+		return
+	}
+
+	if character.Spellcasting == nil {
+		character.Spellcasting = &models.Spellcasting{}
+	}
+	spellcasting := character.Spellcasting
+	if spellcasting.SpellcastingAbility == "" {
+		spellcasting.SpellcastingAbility = ability
+	}
+
+	if casterLevel > 0 {
+		if spellcasting.SpellSlots == nil {
+			spellcasting.SpellSlots = &models.SpellSlots{}
+		}
+		totals := SpellSlotsForCasterLevel(casterLevel)
+		for i, slot := range SpellSlotLevels(spellcasting.SpellSlots) {
+			slot.Total = totals[i]
+			slot.Used = clamp(slot.Used, 0, slot.Total)
+		}
+	} else {
+		spellcasting.SpellSlots = nil
+	}
+
+	if warlockLevel > 0 {
+		if spellcasting.PactMagic == nil {
+			spellcasting.PactMagic = &models.PactMagic{}
+		}
+		spellcasting.PactMagic.Total, spellcasting.PactMagic.SlotLevel = PactMagicSlots(warlockLevel)
+		spellcasting.PactMagic.Used = clamp(spellcasting.PactMagic.Used, 0, spellcasting.PactMagic.Total)
+	} else {
+		spellcasting.PactMagic = nil
+	}
+}
+
+// SpellSlotLevels returns pointers to each spell slot level in order from
+// 1st to 9th
+func SpellSlotLevels(slots *models.SpellSlots) []*models.SpellSlotLevel {
+	return []*models.SpellSlotLevel{
+		&slots.Level1, &slots.Level2, &slots.Level3,
+		&slots.Level4, &slots.Level5, &slots.Level6,
+		&slots.Level7, &slots.Level8, &slots.Level9,
+	}
+}
+
+// spellcastingProgression returns the progression granted by a class, or by
+// its subclass when the class itself does not cast
+func spellcastingProgression(catalog *reference.Catalog, class *reference.Class, subclassName string) string {
+	if class.Spellcasting != nil {
+		return class.Spellcasting.Progression
+	}
+	if subclassName == "" {
+		return ""
+	}
+	if subclass, ok := catalog.Subclass(subclassName); ok && subclass.Spellcasting != nil {
+		return subclass.Spellcasting.Progression
+	}
+	return ""
+}
+
+func clamp(value, minimum, maximum int) int {
+	if value < minimum {
+		return minimum
+	}
+	if value > maximum {
+		return maximum
+	}
+	return value
+}
@@ -0,0 +1,205 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/yourusername/dnd-character-creator/internal/models"
+)
+
+// Which side of a transfer a history entry records
+const (
+	TransferSent     = "sent"
+	TransferReceived = "received"
+)
+
+// Transfer moves items and coins from one character to another and records
+// the transfer in both characters' histories. Items given in full leave the
+// sender's inventory, taking everything packed in them along, and items
+// requested again after going along with their container are skipped; items
+// given in part split the stack. Items arrive unpacked, unequipped and
+// unattuned, stacking onto a matching plain item the receiver already holds.
+// Coins are paid by the sender with change as for a debit and appear in both
+// currency ledgers. Either character may be left part-way changed when
+// Transfer fails, so callers should discard them.
+func (e *Engine) Transfer(from, to *models.Character, request *models.TransferRequest, at time.Time) (models.Transfer, error) {
+	if len(request.Items) == 0 && request.Currency == nil {
+		return models.Transfer{}, errors.New("a transfer must move at least one item or some currency")
+	}
+
+	e.Apply(from)
+	e.Apply(to)
+
+	sent := models.Transfer{
+		ID:            NewItemID(),
+		Direction:     TransferSent,
+		CharacterID:   to.ID,
+		CharacterName: to.CharacterName,
+		Currency:      request.Currency,
+		Memo:          request.Memo,
+		TransferredAt: at,
+	}
+
+	// Original IDs of items that went along inside a container
+	packed := map[string]bool{}
+	for _, requested := range request.Items {
+		if packed[requested.ID] {
+			continue
+		}
+		moved, err := moveItem(from, to, requested, packed)
+		if err != nil {
+			return models.Transfer{}, err
+		}
+		sent.Items = append(sent.Items, moved...)
+	}
+
+	if request.Currency != nil {
+		payment := models.CurrencyTransaction{
+			Type:         TransactionDebit,
+			Amount:       request.Currency.Amount,
+			Denomination: request.Currency.Denomination,
+			Memo:         transferMemo("Transfer to "+to.CharacterName, request.Memo),
+			TransactedAt: at,
+		}
+		if _, err := e.Transact(from, payment); err != nil {
+			return models.Transfer{}, err
+		}
+
+		payment.Type = TransactionCredit
+		payment.Memo = transferMemo("Transfer from "+from.CharacterName, request.Memo)
+		if _, err := e.Transact(to, payment); err != nil {
+			return models.Transfer{}, err
+		}
+	}
+
+	received := sent
+	received.Direction = TransferReceived
+	received.CharacterID = from.ID
+	received.CharacterName = from.CharacterName
+
+	from.Transfers = append(from.Transfers, sent)
+	to.Transfers = append(to.Transfers, received)
+
+	e.Apply(from)
+	e.Apply(to)
+
+	return sent, nil
+}
+
+// moveItem moves the requested quantity of an inventory item from one
+// character to the other, along with its contents when it is a container given
+// in full. The IDs of contents moved along are added to packed.
+func moveItem(from, to *models.Character, requested models.TransferItemRequest, packed map[string]bool) ([]models.TransferredItem, error) {
+	item := findInventoryItem(from, requested.ID)
+	if item == nil {
+		return nil, fmt.Errorf("%s has no item %q", from.CharacterName, requested.ID)
+	}
+
+	available := max(item.Quantity, 1)
+	quantity := requested.Quantity
+	if quantity == 0 {
+		quantity = available
+	}
+	if quantity > available {
+		return nil, fmt.Errorf("only %d of %s to give", available, ItemName(item))
+	}
+
+	moved := *item
+	moved.ID = NewItemID()
+	moved.Quantity = quantity
+	moved.Equipped = false
+	moved.Attuned = false
+	moved.ContainerID = ""
+	if item.Charges != nil {
+		charges := *item.Charges
+		moved.Charges = &charges
+	}
+
+	if quantity == available {
+		from.Inventory.Items = slices.DeleteFunc(from.Inventory.Items, func(i models.InventoryItem) bool {
+			return i.ID == requested.ID
+		})
+	} else {
+		item.Quantity -= quantity
+	}
+
+	if to.Inventory == nil {
+		to.Inventory = &models.Inventory{}
+	}
+	if stack := findStack(to.Inventory, &moved); stack != nil {
+		stack.Quantity = max(stack.Quantity, 1) + quantity
+		moved.ID = stack.ID
+	} else {
+		to.Inventory.Items = append(to.Inventory.Items, moved)
+	}
+
+	transferred := []models.TransferredItem{transferredItem(&moved, quantity)}
+	if quantity == available {
+		transferred = append(transferred, moveContents(from, to, requested.ID, moved.ID, packed)...)
+	}
+	return transferred, nil
+}
+
+// moveContents moves everything packed in a container given in full to the
+// receiver, packed in the container's new entry, and records the IDs it moved
+// in packed
+func moveContents(from, to *models.Character, containerID string, receivedID string, packed map[string]bool) []models.TransferredItem {
+	var contents []models.InventoryItem
+	from.Inventory.Items = slices.DeleteFunc(from.Inventory.Items, func(item models.InventoryItem) bool {
+		if item.ContainerID != containerID {
+			return false
+		}
+		contents = append(contents, item)
+		return true
+	})
+
+	var transferred []models.TransferredItem
+	for _, item := range contents {
+		id := item.ID
+		packed[id] = true
+		item.ID = NewItemID()
+		item.ContainerID = receivedID
+		item.Equipped = false
+		item.Attuned = false
+		to.Inventory.Items = append(to.Inventory.Items, item)
+		transferred = append(transferred, transferredItem(&item, max(item.Quantity, 1)))
+		transferred = append(transferred, moveContents(from, to, id, item.ID, packed)...)
+	}
+	return transferred
+}
+
+// transferredItem records quantity of an item as moved by a transfer
+func transferredItem(item *models.InventoryItem, quantity int) models.TransferredItem {
+	return models.TransferredItem{
+		ID:       item.ID,
+		ItemID:   item.ItemID,
+		Name:     ItemName(item),
+		Quantity: quantity,
+	}
+}
+
+// findStack returns the receiver's item that a moved item can stack onto:
+// the same unpacked item under the same name and notes, with no charges or
+// contents to keep apart
+func findStack(inventory *models.Inventory, moved *models.InventoryItem) *models.InventoryItem {
+	if moved.Charges != nil || itemContainer(moved.Item) != nil {
+		return nil
+	}
+	for i := range inventory.Items {
+		item := &inventory.Items[i]
+		if item.ItemID == moved.ItemID && item.Name == moved.Name && item.Notes == moved.Notes && item.Charges == nil && item.ContainerID == "" {
+			return item
+		}
+	}
+	return nil
+}
+
+// transferMemo is the currency ledger memo for one side of a transfer
+func transferMemo(summary string, memo string) string {
+	if memo == "" {
+		return summary
+	}
+	return summary + ": " + memo
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/yourusername/dnd-character-creator/internal/dice"
+	"github.com/yourusername/dnd-character-creator/internal/logger"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
+)
+
+// AbilityScoreService generates and verifies ability scores. Rolled scores
+// always come from a seed chosen and signed by the server so they cannot be
+// fudged.
+type AbilityScoreService struct {
+	rollSecret []byte
+}
+
+// NewAbilityScoreService creates a new ability score service that signs
+// rolled seeds with rollSecret
+func NewAbilityScoreService(rollSecret string) *AbilityScoreService {
+	return &AbilityScoreService{rollSecret: []byte(rollSecret)}
+}
+
+// Generate produces a set of scores using the given method
+func (s *AbilityScoreService) Generate(method string) (*models.AbilityScoreGeneration, error) {
+	generation := &models.AbilityScoreGeneration{Method: method}
+
+	switch method {
+	case rules.MethodPointBuy:
+		generation.Values = []int{8, 8, 8, 8, 8, 8}
+		generation.PointBuy = &models.PointBuyRules{
+			Budget:  rules.PointBuyBudget,
+			Minimum: rules.PointBuyMinimum,
+			Maximum: rules.PointBuyMaximum,
+			Costs:   rules.PointBuyCosts(),
+		}
+	case rules.MethodStandardArray:
+		generation.Values = rules.StandardArray()
+	case rules.MethodRolled:
+		seed := dice.NewSeed()
+		generation.Seed = dice.SignSeed(s.rollSecret, seed)
+		generation.Rolls = rules.RollAbilityScores(seed)
+		for _, roll := range generation.Rolls {
+			generation.Values = append(generation.Values, roll.Total)
+		}
+		logger.GetLogger().Infof("Rolled ability scores %v with seed %s", generation.Values, generation.Seed)
+	default:
+		return nil, fmt.Errorf("unknown ability score method %q", method)
+	}
+
+	return generation, nil
+}
+
+// Validate checks that a set of ability scores matches its declared method
+func (s *AbilityScoreService) Validate(request *models.AbilityScoreValidationRequest) *models.AbilityScoreValidation {
+	scores := rules.AbilityScoreValues(&request.AbilityScores)
+	if rules.HasBaseScores(&request.AbilityScores) {
+		scores = rules.BaseScoreValues(&request.AbilityScores)
+	}
+	errors := rules.ValidateAbilityScores(request.Method, scores, request.Seed)
+	if request.Method == rules.MethodRolled && request.Seed != "" {
+		if err := dice.VerifySeed(s.rollSecret, request.Seed); err != nil {
+			errors = append(errors, err.Error())
+		}
+	}
+
+	result := &models.AbilityScoreValidation{
+		Valid:  len(errors) == 0,
+		Errors: errors,
+	}
+
+	if request.Method == rules.MethodPointBuy {
+		if spent, err := rules.PointBuyCost(scores); err == nil {
+			result.PointsSpent = &spent
+		}
+	}
+
+	return result
+}
@@ -4,25 +4,83 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
+	"github.com/yourusername/dnd-character-creator/internal/config"
+	"github.com/yourusername/dnd-character-creator/internal/dice"
 	"github.com/yourusername/dnd-character-creator/internal/logger"
 	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/reference"
 	"github.com/yourusername/dnd-character-creator/internal/repository"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
 	"github.com/yourusername/dnd-character-creator/internal/validator"
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
+// Errors returned by CharacterService, which callers match with errors.Is
+var (
+	// ErrCharacterNotFound is returned when no character has the given ID
+	ErrCharacterNotFound = errors.New("character not found")
+	// ErrCharacterNameExists is returned when another character already has
+	// the name
+	ErrCharacterNameExists = errors.New("character name already exists")
+	// ErrVersionConflict is returned when a character keeps changing
+	// underneath an update
+	ErrVersionConflict = errors.New("character was modified by another request")
+	// ErrStorage is wrapped by every failure to read or write characters and
+	// items, which are server faults rather than problems with the request
+	ErrStorage = errors.New("storage failure")
+)
+
+// storageError wraps a repository failure in ErrStorage while keeping its
+// message
+type storageError struct {
+	action string
+	err    error
+}
+
+func (e *storageError) Error() string {
+	return "failed to " + e.action + ": " + e.err.Error()
+}
+
+func (e *storageError) Unwrap() []error {
+	return []error{ErrStorage, e.err}
+}
+
+// modifyAttempts is how many times modify reapplies a change after losing a
+// race with another update
+const modifyAttempts = 3
+
 // CharacterService handles business logic for characters
 type CharacterService struct {
 	repo      repository.CharacterRepository
 	validator *validator.CharacterValidator
+	// actions validates characters changed through action endpoints, which
+	// only warn about catalog rules so characters saved before strict mode
+	// can still be played
+	actions *validator.CharacterValidator
+	items   repository.ItemRepository
+	catalog *reference.Catalog
+	rules   *rules.Engine
+	dice    *dice.Roller
+	// rollSecret verifies that rolled ability scores use a seed this server
+	// issued
+	rollSecret []byte
 }
 
-// NewCharacterService creates a new character service
-func NewCharacterService(repo repository.CharacterRepository) *CharacterService {
+// NewCharacterService creates a new character service. Without an item
+// repository inventory items can only reference SRD items.
+func NewCharacterService(repo repository.CharacterRepository, items repository.ItemRepository, catalog *reference.Catalog, cfg config.RulesConfig) *CharacterService {
 	return &CharacterService{
-		repo:      repo,
-		validator: validator.NewCharacterValidator(),
+		repo:       repo,
+		items:      items,
+		catalog:    catalog,
+		validator:  validator.NewRulesValidator(catalog, validator.ParseMode(cfg.ValidationMode)).RestrictMethods(cfg.AbilityScoreMethods),
+		actions:    validator.NewRulesValidator(catalog, validator.ModeLenient),
+		rules:      rules.NewEngine(catalog, cfg),
+		dice:       dice.NewRandomRoller(),
+		rollSecret: []byte(cfg.RollSecret),
 	}
 }
 
@@ -33,7 +91,20 @@ func (s *CharacterService) GetAll(ctx context.Context, filter repository.Charact
 	characters, err := s.repo.FindAll(ctx, filter)
 	if err != nil {
 		logger.GetLogger().WithError(err).Error("Failed to fetch characters")
-		return nil, fmt.Errorf("failed to fetch characters: %w", err)
+		return nil, &storageError{"fetch characters", err}
+	}
+
+	// Item stats are resolved on every read so changes to items reach
+	// every character holding them
+	refs := make([]*models.Character, len(characters))
+	for i := range characters {
+		refs[i] = &characters[i]
+	}
+	if err := s.resolveItems(ctx, refs...); err != nil {
+		return nil, err
+	}
+	for _, character := range refs {
+		s.rules.Apply(character)
 	}
 
 	logger.GetLogger().Infof("Found %d characters", len(characters))
@@ -47,7 +118,7 @@ func (s *CharacterService) GetByID(ctx context.Context, id string) (*models.Char
 	character, err := s.repo.FindByID(ctx, id)
 	if err != nil {
 		logger.GetLogger().WithError(err).Error("Failed to fetch character")
-		return nil, fmt.Errorf("failed to fetch character: %w", err)
+		return nil, &storageError{"fetch character", err}
 	}
 
 	if character == nil {
@@ -55,6 +126,11 @@ func (s *CharacterService) GetByID(ctx context.Context, id string) (*models.Char
 		return nil, nil
 	}
 
+	if err := s.resolveItems(ctx, character); err != nil {
+		return nil, err
+	}
+	s.rules.Apply(character)
+
 	return character, nil
 }
 
@@ -63,32 +139,49 @@ func (s *CharacterService) Create(ctx context.Context, character *models.Charact
 	logger.GetLogger().Infof("Creating new character: %s", character.CharacterName)
 
 	// Validate character data
-	validationErrors := s.validator.Validate(character)
-	if len(validationErrors) > 0 {
-		logger.GetLogger().Warnf("Validation errors for character: %v", validationErrors)
-		return nil, fmt.Errorf("validation errors: %v", validationErrors)
+	if err := s.resolveItems(ctx, character); err != nil {
+		return nil, err
+	}
+	if err := s.checkItems(character); err != nil {
+		return nil, err
+	}
+	if err := s.validate(character); err != nil {
+		return nil, err
+	}
+	if err := s.checkSeed(character, nil); err != nil {
+		return nil, err
 	}
 
 	// Check if character name already exists
 	exists, err := s.repo.ExistsByName(ctx, character.CharacterName, "")
 	if err != nil {
 		logger.GetLogger().WithError(err).Error("Failed to check character name existence")
-		return nil, fmt.Errorf("failed to check character name: %w", err)
+		return nil, &storageError{"check character name", err}
 	}
 
 	if exists {
 		logger.GetLogger().Warnf("Character name already exists: %s", character.CharacterName)
-		return nil, errors.New("character name already exists")
+		return nil, ErrCharacterNameExists
 	}
 
-	// Calculate ability modifiers
-	s.calculateAbilityModifiers(&character.AbilityScores)
+	// Experience awards, currency transactions and transfers are only
+	// recorded through their own endpoints
+	character.ExperienceLedger = nil
+	character.CurrencyLedger = nil
+	character.Transfers = nil
+
+	// A new character's maximum is taken as its full maximum, before any
+	// exhaustion reduction
+	character.HitPoints.Reduction = 0
+
+	// Calculate derived stats
+	s.rules.Apply(character)
 
 	// Create character
 	err = s.repo.Create(ctx, character)
 	if err != nil {
 		logger.GetLogger().WithError(err).Error("Failed to create character")
-		return nil, fmt.Errorf("failed to create character: %w", err)
+		return nil, &storageError{"create character", err}
 	}
 
 	logger.GetLogger().Infof("Successfully created character: %s", character.CharacterName)
@@ -103,19 +196,28 @@ func (s *CharacterService) Update(ctx context.Context, id string, character *mod
 	existing, err := s.repo.FindByID(ctx, id)
 	if err != nil {
 		logger.GetLogger().WithError(err).Error("Failed to fetch existing character")
-		return nil, fmt.Errorf("failed to fetch character: %w", err)
+		return nil, &storageError{"fetch character", err}
 	}
 
 	if existing == nil {
 		logger.GetLogger().Warnf("Character not found with ID: %s", id)
-		return nil, errors.New("character not found")
+		return nil, ErrCharacterNotFound
 	}
 
+	deriveClassLevel(character, existing)
+
 	// Validate character data
-	validationErrors := s.validator.Validate(character)
-	if len(validationErrors) > 0 {
-		logger.GetLogger().Warnf("Validation errors for character: %v", validationErrors)
-		return nil, fmt.Errorf("validation errors: %v", validationErrors)
+	if err := s.resolveItems(ctx, character); err != nil {
+		return nil, err
+	}
+	if err := s.checkItems(character); err != nil {
+		return nil, err
+	}
+	if err := s.validate(character); err != nil {
+		return nil, err
+	}
+	if err := s.checkSeed(character, existing); err != nil {
+		return nil, err
 	}
 
 	// Check if new name conflicts with existing character
@@ -123,30 +225,57 @@ func (s *CharacterService) Update(ctx context.Context, id string, character *mod
 		exists, err := s.repo.ExistsByName(ctx, character.CharacterName, id)
 		if err != nil {
 			logger.GetLogger().WithError(err).Error("Failed to check character name existence")
-			return nil, fmt.Errorf("failed to check character name: %w", err)
+			return nil, &storageError{"check character name", err}
 		}
 
 		if exists {
 			logger.GetLogger().Warnf("Character name already exists: %s", character.CharacterName)
-			return nil, errors.New("character name already exists")
+			return nil, ErrCharacterNameExists
 		}
 	}
 
-	// Calculate ability modifiers
-	s.calculateAbilityModifiers(&character.AbilityScores)
+	// Past hit point rolls, experience awards, currency transactions and
+	// transfers are part of the character's record and only change through
+	// their own endpoints
+	character.HitPoints.History = rules.PreserveHitPointHistory(existing.HitPoints.History, character.HitPoints.History)
+	character.ExperienceLedger = existing.ExperienceLedger
+	if len(existing.ExperienceLedger) > 0 {
+		character.ExperiencePoints = existing.ExperiencePoints
+	}
+	character.CurrencyLedger = existing.CurrencyLedger
+	if len(existing.CurrencyLedger) > 0 {
+		preserveCurrency(character, existing)
+	}
+	character.Transfers = existing.Transfers
+
+	// The maximum sent back is the one the client was shown, so the hit points
+	// exhaustion took from it are carried over
+	character.HitPoints.Reduction = existing.HitPoints.Reduction
+
+	// Calculate derived stats
+	s.rules.Apply(character)
 
 	// Preserve creation timestamp
 	character.CreatedAt = existing.CreatedAt
 
+	// Clients that send the version they edited get a conflict instead of
+	// overwriting a newer change
+	if character.Version == 0 {
+		character.Version = existing.Version
+	}
+
 	// Update character
 	err = s.repo.Update(ctx, id, character)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			logger.GetLogger().Warnf("Character not found with ID: %s", id)
-			return nil, errors.New("character not found")
+			return nil, ErrCharacterNotFound
+		}
+		if errors.Is(err, repository.ErrVersionConflict) {
+			return nil, ErrVersionConflict
 		}
 		logger.GetLogger().WithError(err).Error("Failed to update character")
-		return nil, fmt.Errorf("failed to update character: %w", err)
+		return nil, &storageError{"update character", err}
 	}
 
 	logger.GetLogger().Infof("Successfully updated character: %s", character.CharacterName)
@@ -161,27 +290,611 @@ func (s *CharacterService) Delete(ctx context.Context, id string) error {
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			logger.GetLogger().Warnf("Character not found with ID: %s", id)
-			return errors.New("character not found")
+			return ErrCharacterNotFound
 		}
 		logger.GetLogger().WithError(err).Error("Failed to delete character")
-		return fmt.Errorf("failed to delete character: %w", err)
+		return &storageError{"delete character", err}
 	}
 
 	logger.GetLogger().Infof("Successfully deleted character with ID: %s", id)
 	return nil
 }
 
-// calculateAbilityModifiers calculates the modifiers for all ability scores
-func (s *CharacterService) calculateAbilityModifiers(scores *models.AbilityScores) {
-	scores.Strength.Modifier = calculateModifier(scores.Strength.Score)
-	scores.Dexterity.Modifier = calculateModifier(scores.Dexterity.Score)
-	scores.Constitution.Modifier = calculateModifier(scores.Constitution.Score)
-	scores.Intelligence.Modifier = calculateModifier(scores.Intelligence.Score)
-	scores.Wisdom.Modifier = calculateModifier(scores.Wisdom.Score)
-	scores.Charisma.Modifier = calculateModifier(scores.Charisma.Score)
+// LevelUpOptions returns what the character gains and must choose when taking
+// the next level in a class
+func (s *CharacterService) LevelUpOptions(ctx context.Context, id string, class string) (*models.LevelUpOptions, error) {
+	character, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if character == nil {
+		return nil, ErrCharacterNotFound
+	}
+
+	return s.rules.LevelUpOptions(character, class)
+}
+
+// LevelUp advances a character by one level in a class. Hit points for
+// characters using rolled growth are rolled on the server.
+func (s *CharacterService) LevelUp(ctx context.Context, id string, request *models.LevelUpRequest) (*models.LevelUpResult, error) {
+	logger.GetLogger().Infof("Levelling up character with ID: %s in %s", id, request.Class)
+
+	var result *models.LevelUpResult
+	err := s.modify(ctx, id, func(character *models.Character) (err error) {
+		result, err = s.rules.LevelUp(character, request, s.dice.Die)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.GetLogger().Infof("Character %s is now level %d", result.Character.CharacterName, result.Character.Level)
+	return result, nil
+}
+
+// AwardExperience adds an experience award to a character's ledger
+func (s *CharacterService) AwardExperience(ctx context.Context, id string, request *models.ExperienceAwardRequest) (*models.ExperienceAwardResult, error) {
+	logger.GetLogger().Infof("Awarding %d experience to character with ID: %s", request.Amount, id)
+
+	var result *models.ExperienceAwardResult
+	err := s.modify(ctx, id, func(character *models.Character) (err error) {
+		result, err = s.rules.AwardExperience(character, models.ExperienceAward{
+			Amount:    request.Amount,
+			Reason:    request.Reason,
+			Encounter: request.Encounter,
+			AwardedAt: time.Now(),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.ThresholdCrossed {
+		logger.GetLogger().Infof("Character %s reached the experience for level %d", result.Character.CharacterName, result.EligibleLevel)
+	}
+	return result, nil
+}
+
+// Transact credits or debits a character's purse, making change as needed,
+// and records the transaction in the currency ledger
+func (s *CharacterService) Transact(ctx context.Context, id string, request *models.CurrencyTransactionRequest) (*models.CurrencyTransactionResult, error) {
+	logger.GetLogger().Infof("Recording %s of %d %s for character with ID: %s", request.Type, request.Amount, request.Denomination, id)
+
+	var result *models.CurrencyTransactionResult
+	err := s.modify(ctx, id, func(character *models.Character) (err error) {
+		result, err = s.rules.Transact(character, models.CurrencyTransaction{
+			Type:         request.Type,
+			Amount:       request.Amount,
+			Denomination: request.Denomination,
+			Memo:         request.Memo,
+			TransactedAt: time.Now(),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ChangeHitPoints applies damage, healing or temporary hit points to a
+// character. The change is applied to the latest stored hit points so
+// simultaneous changes from different clients all take effect.
+func (s *CharacterService) ChangeHitPoints(ctx context.Context, id string, request *models.HitPointChangeRequest) (*models.HitPointChangeResult, error) {
+	logger.GetLogger().Infof("Applying %d %s to character with ID: %s", request.Amount, request.Type, id)
+
+	var result *models.HitPointChangeResult
+	err := s.modify(ctx, id, func(character *models.Character) (err error) {
+		result, err = s.rules.ChangeHitPoints(character, request)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Dead {
+		logger.GetLogger().Infof("Character %s has died", result.Character.CharacterName)
+	}
+	return result, nil
+}
+
+// DeathSave rolls a death saving throw on the server for a dying character
+func (s *CharacterService) DeathSave(ctx context.Context, id string) (*models.DeathSaveResult, error) {
+	logger.GetLogger().Infof("Rolling death save for character with ID: %s", id)
+
+	var result *models.DeathSaveResult
+	err := s.modify(ctx, id, func(character *models.Character) (err error) {
+		result, err = s.rules.DeathSave(character, s.dice.Die)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.GetLogger().Infof("Character %s rolled %d on a death save and is %s", result.Character.CharacterName, result.Roll, result.Status)
+	return result, nil
+}
+
+// Cast casts a spell, spending the slot it uses
+func (s *CharacterService) Cast(ctx context.Context, id string, request *models.CastRequest) (*models.CastResult, error) {
+	logger.GetLogger().Infof("Casting %s for character with ID: %s", request.Spell, id)
+
+	var result *models.CastResult
+	err := s.modify(ctx, id, func(character *models.Character) (err error) {
+		result, err = s.rules.Cast(character, request)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// RollAttack rolls one of the character's attacks. Rolling an attack doesn't
+// change the character.
+func (s *CharacterService) RollAttack(ctx context.Context, id string, index int, request *models.AttackRollRequest) (*models.AttackRollResult, error) {
+	character, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if character == nil {
+		return nil, ErrCharacterNotFound
+	}
+
+	return s.rules.RollAttack(character, index, request, s.dice.Die)
+}
+
+// AddCondition applies a condition or a level of exhaustion to a character
+func (s *CharacterService) AddCondition(ctx context.Context, id string, request *models.ConditionRequest) (*models.Character, error) {
+	logger.GetLogger().Infof("Adding condition %s to character with ID: %s", request.Name, id)
+
+	var updated *models.Character
+	err := s.modify(ctx, id, func(character *models.Character) error {
+		updated = character
+		return s.rules.AddCondition(character, request)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// RemoveCondition ends a condition or removes a level of exhaustion
+func (s *CharacterService) RemoveCondition(ctx context.Context, id string, name string) (*models.Character, error) {
+	logger.GetLogger().Infof("Removing condition %s from character with ID: %s", name, id)
+
+	var updated *models.Character
+	err := s.modify(ctx, id, func(character *models.Character) error {
+		updated = character
+		return s.rules.RemoveCondition(character, name)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// SpendResource uses some of a character's limited-use resource
+func (s *CharacterService) SpendResource(ctx context.Context, id string, name string, request *models.ResourceUseRequest) (*models.Character, error) {
+	logger.GetLogger().Infof("Spending %s for character with ID: %s", name, id)
+
+	var updated *models.Character
+	err := s.modify(ctx, id, func(character *models.Character) error {
+		updated = character
+		return s.rules.SpendResource(character, name, request.Amount)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// RestoreResource regains uses of a character's limited-use resource
+func (s *CharacterService) RestoreResource(ctx context.Context, id string, name string, request *models.ResourceUseRequest) (*models.Character, error) {
+	logger.GetLogger().Infof("Restoring %s for character with ID: %s", name, id)
+
+	var updated *models.Character
+	err := s.modify(ctx, id, func(character *models.Character) error {
+		updated = character
+		return s.rules.RestoreResource(character, name, request.Amount)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// Equip equips an inventory item, optionally attuning to it
+func (s *CharacterService) Equip(ctx context.Context, id string, itemID string, request *models.EquipRequest) (*models.Character, error) {
+	logger.GetLogger().Infof("Equipping item %s on character with ID: %s", itemID, id)
+
+	var updated *models.Character
+	err := s.modify(ctx, id, func(character *models.Character) error {
+		updated = character
+		return s.rules.Equip(character, itemID, request)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// Unequip unequips an inventory item, optionally ending attunement to it
+func (s *CharacterService) Unequip(ctx context.Context, id string, itemID string, request *models.UnequipRequest) (*models.Character, error) {
+	logger.GetLogger().Infof("Unequipping item %s on character with ID: %s", itemID, id)
+
+	var updated *models.Character
+	err := s.modify(ctx, id, func(character *models.Character) error {
+		updated = character
+		return s.rules.Unequip(character, itemID, request)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// MoveItem packs an inventory item into a container or takes it out of one
+func (s *CharacterService) MoveItem(ctx context.Context, id string, itemID string, request *models.MoveItemRequest) (*models.Character, error) {
+	logger.GetLogger().Infof("Moving item %s on character with ID: %s", itemID, id)
+
+	var updated *models.Character
+	err := s.modify(ctx, id, func(character *models.Character) error {
+		updated = character
+		return s.rules.MoveItem(character, itemID, request)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// SpendCharges uses charges of a magic item in the character's inventory
+func (s *CharacterService) SpendCharges(ctx context.Context, id string, itemID string, request *models.ItemChargeRequest) (*models.Character, error) {
+	logger.GetLogger().Infof("Spending charges of item %s on character with ID: %s", itemID, id)
+
+	var updated *models.Character
+	err := s.modify(ctx, id, func(character *models.Character) error {
+		updated = character
+		return s.rules.SpendCharges(character, itemID, request.Amount)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// ShortRest spends hit dice to heal and restores short rest resources. Hit
+// dice are rolled on the server.
+func (s *CharacterService) ShortRest(ctx context.Context, id string, request *models.ShortRestRequest) (*models.RestResult, error) {
+	logger.GetLogger().Infof("Short rest for character with ID: %s", id)
+
+	var result *models.RestResult
+	err := s.modify(ctx, id, func(character *models.Character) (err error) {
+		result, err = s.rules.ShortRest(character, request, s.dice.Die)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// LongRest restores a character's hit points, hit dice and spell slots
+func (s *CharacterService) LongRest(ctx context.Context, id string) (*models.RestResult, error) {
+	logger.GetLogger().Infof("Long rest for character with ID: %s", id)
+
+	var result *models.RestResult
+	err := s.modify(ctx, id, func(character *models.Character) (err error) {
+		result, err = s.rules.LongRest(character)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Dawn restores the resources and magic item charges that recharge at dawn.
+// Charges regained by a roll are rolled on the server.
+func (s *CharacterService) Dawn(ctx context.Context, id string) (*models.RestResult, error) {
+	logger.GetLogger().Infof("Dawn for character with ID: %s", id)
+
+	var result *models.RestResult
+	err := s.modify(ctx, id, func(character *models.Character) error {
+		result = s.rules.Dawn(character, s.dice.Die)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Transfer moves items and coins from one character to another. Both
+// characters are saved in one transaction so the transfer either happens in
+// full or not at all, and it is retried when either character changes
+// underneath it.
+func (s *CharacterService) Transfer(ctx context.Context, request *models.TransferRequest) (*models.TransferResult, error) {
+	logger.GetLogger().Infof("Transferring from character %s to character %s", request.FromCharacterID, request.ToCharacterID)
+
+	if request.FromCharacterID == request.ToCharacterID {
+		return nil, errors.New("a character cannot transfer to itself")
+	}
+
+	for range modifyAttempts {
+		var result *models.TransferResult
+		var rejected error
+		err := s.repo.WithTransaction(ctx, func(ctx context.Context) error {
+			result, rejected = s.tryTransfer(ctx, request)
+			return rejected
+		})
+		if err != nil && !errors.Is(err, rejected) {
+			// The transaction itself failed rather than the transfer
+			err = &storageError{"transfer", err}
+		}
+		if !errors.Is(err, repository.ErrVersionConflict) {
+			if err != nil {
+				return nil, err
+			}
+			return result, nil
+		}
+		logger.GetLogger().Warnf("Characters %s or %s changed during transfer, retrying", request.FromCharacterID, request.ToCharacterID)
+	}
+	return nil, ErrVersionConflict
+}
+
+// tryTransfer makes a single attempt at a transfer for Transfer
+func (s *CharacterService) tryTransfer(ctx context.Context, request *models.TransferRequest) (*models.TransferResult, error) {
+	from, err := s.load(ctx, request.FromCharacterID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.load(ctx, request.ToCharacterID)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := s.rules.Transfer(from, to, request, time.Now())
+	if err != nil {
+		logger.GetLogger().Warnf("Transfer rejected from character %s to character %s: %v", from.ID, to.ID, err)
+		return nil, err
+	}
+
+	if err := s.save(ctx, request.FromCharacterID, from); err != nil {
+		return nil, err
+	}
+	if err := s.save(ctx, request.ToCharacterID, to); err != nil {
+		return nil, err
+	}
+
+	return &models.TransferResult{From: from, To: to, Transfer: transfer}, nil
+}
+
+// modify loads a character, applies a change to it, then validates and saves
+// the result. Errors returned by change are passed through unchanged. When
+// another request saves the character first, the change is reapplied to the
+// newer version so concurrent changes are never lost.
+func (s *CharacterService) modify(ctx context.Context, id string, change func(*models.Character) error) error {
+	for range modifyAttempts {
+		err := s.tryModify(ctx, id, change)
+		if !errors.Is(err, repository.ErrVersionConflict) {
+			return err
+		}
+		logger.GetLogger().Warnf("Character %s changed during update, retrying", id)
+	}
+	return ErrVersionConflict
+}
+
+// tryModify makes a single attempt at a change for modify
+func (s *CharacterService) tryModify(ctx context.Context, id string, change func(*models.Character) error) error {
+	character, err := s.load(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := change(character); err != nil {
+		logger.GetLogger().Warnf("Change rejected for character %s: %v", id, err)
+		return err
+	}
+
+	return s.save(ctx, id, character)
+}
+
+// load fetches a character to be changed and resolves its homebrew items
+func (s *CharacterService) load(ctx context.Context, id string) (*models.Character, error) {
+	character, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to fetch character")
+		return nil, &storageError{"fetch character", err}
+	}
+
+	if character == nil {
+		logger.GetLogger().Warnf("Character not found with ID: %s", id)
+		return nil, ErrCharacterNotFound
+	}
+
+	if err := s.resolveItems(ctx, character); err != nil {
+		return nil, err
+	}
+
+	return character, nil
+}
+
+// save validates a changed character and stores it. Version conflicts are
+// returned as repository.ErrVersionConflict so the change can be retried.
+func (s *CharacterService) save(ctx context.Context, id string, character *models.Character) error {
+	if err := s.validateWith(s.actions, character); err != nil {
+		return err
+	}
+
+	err := s.repo.Update(ctx, id, character)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			logger.GetLogger().Warnf("Character not found with ID: %s", id)
+			return ErrCharacterNotFound
+		}
+		if errors.Is(err, repository.ErrVersionConflict) {
+			return err
+		}
+		logger.GetLogger().WithError(err).Error("Failed to update character")
+		return &storageError{"update character", err}
+	}
+
+	return nil
+}
+
+// validate runs the configured character validator on a created or edited
+// character. Catalog rule violations are errors in strict mode and are only
+// logged as warnings in lenient mode.
+func (s *CharacterService) validate(character *models.Character) error {
+	return s.validateWith(s.validator, character)
 }
 
-// calculateModifier calculates the modifier for an ability score
-func calculateModifier(score int) int {
-	return (score - 10) / 2
+// validateWith runs the given character validator
+func (s *CharacterService) validateWith(v *validator.CharacterValidator, character *models.Character) error {
+	s.rules.ResolveAbilityScores(character)
+
+	validationErrors := v.Validate(character)
+	if len(validationErrors) > 0 {
+		logger.GetLogger().Warnf("Validation errors for character: %v", validationErrors)
+		return fmt.Errorf("validation errors: %v", validationErrors)
+	}
+
+	if warnings := v.Warnings(character); len(warnings) > 0 {
+		logger.GetLogger().Warnf("Rules warnings for character %s: %v", character.CharacterName, warnings)
+	}
+
+	return nil
+}
+
+// checkSeed verifies that rolled base scores use a seed this server signed.
+// Stored scores were checked when they were set, so only new or changed
+// rolled scores are checked, which keeps characters valid across secret
+// changes.
+func (s *CharacterService) checkSeed(character, existing *models.Character) error {
+	scores := &character.AbilityScores
+	if scores.Method != rules.MethodRolled || !rules.HasBaseScores(scores) {
+		return nil
+	}
+	if existing != nil {
+		stored := &existing.AbilityScores
+		if stored.Method == scores.Method && stored.Seed == scores.Seed && slices.Equal(rules.BaseScoreValues(stored), rules.BaseScoreValues(scores)) {
+			return nil
+		}
+	}
+
+	if err := dice.VerifySeed(s.rollSecret, scores.Seed); err != nil {
+		logger.GetLogger().Warnf("Rejected rolled ability scores for character %s: %v", character.CharacterName, err)
+		return fmt.Errorf("validation errors: [abilityScores.seed: %v]", err)
+	}
+	return nil
+}
+
+// deriveClassLevel clears a class level the client echoed back unchanged
+// while changing only the total level, so the primary class takes the new
+// levels. Any other mismatch is left for validation to reject.
+func deriveClassLevel(character, existing *models.Character) {
+	if character.ClassLevel != existing.ClassLevel || character.Level == existing.Level {
+		return
+	}
+	if rules.MulticlassLevels(character) != rules.MulticlassLevels(existing) {
+		return
+	}
+	character.ClassLevel = 0
+}
+
+// preserveCurrency keeps the stored purse, which only changes through
+// currency transactions once the character has any
+func preserveCurrency(character, existing *models.Character) {
+	var currency *models.Currency
+	if existing.Inventory != nil && existing.Inventory.Currency != nil {
+		stored := *existing.Inventory.Currency
+		currency = &stored
+	}
+	if character.Inventory == nil {
+		character.Inventory = &models.Inventory{}
+	}
+	character.Inventory.Currency = currency
+}
+
+// resolveItems loads the homebrew items referenced by the characters'
+// inventories onto their entries. SRD items are resolved by the rules engine.
+func (s *CharacterService) resolveItems(ctx context.Context, characters ...*models.Character) error {
+	if s.items == nil {
+		return nil
+	}
+
+	var ids []string
+	for _, character := range characters {
+		if character.Inventory == nil {
+			continue
+		}
+		for _, item := range character.Inventory.Items {
+			if !rules.IsCatalogItem(s.catalog, item.ItemID) && !slices.Contains(ids, item.ItemID) {
+				ids = append(ids, item.ItemID)
+			}
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	homebrew, err := s.items.FindByIDs(ctx, ids)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to fetch homebrew items")
+		return &storageError{"fetch items", err}
+	}
+
+	for _, character := range characters {
+		if character.Inventory == nil {
+			continue
+		}
+		for i := range character.Inventory.Items {
+			item := &character.Inventory.Items[i]
+			for j := range homebrew {
+				if homebrew[j].ID == item.ItemID {
+					stats := homebrew[j]
+					item.Item = &stats
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// checkItems rejects inventory items that reference neither an SRD nor a
+// homebrew item
+func (s *CharacterService) checkItems(character *models.Character) error {
+	if character.Inventory == nil {
+		return nil
+	}
+
+	var validationErrors []string
+	for i := range character.Inventory.Items {
+		item := &character.Inventory.Items[i]
+		if rules.ItemStats(s.catalog, item) == nil {
+			validationErrors = append(validationErrors, fmt.Sprintf("inventory.items[%d] %q is not an SRD or homebrew item", i, item.ItemID))
+		}
+	}
+
+	if len(validationErrors) > 0 {
+		logger.GetLogger().Warnf("Validation errors for character: %v", validationErrors)
+		return fmt.Errorf("validation errors: %v", validationErrors)
+	}
+	return nil
 }
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"github.com/yourusername/dnd-character-creator/internal/dice"
+	"github.com/yourusername/dnd-character-creator/internal/logger"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+)
+
+// DiceService rolls dice notation. Every roll gets its own seed, returned with
+// the result, so it can be repeated exactly.
+type DiceService struct{}
+
+// NewDiceService creates a new dice service
+func NewDiceService() *DiceService {
+	return &DiceService{}
+}
+
+// Roll rolls the requested notation with the request's seed, or a new one
+func (s *DiceService) Roll(request *models.RollRequest) (*models.RollResult, error) {
+	seed := dice.NewSeed()
+	if request.Seed != "" {
+		var err error
+		if seed, err = dice.ParseSeed(request.Seed); err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := dice.Roll(dice.NewRoller(seed).Die, request.Notation, request.Mode)
+	if err != nil {
+		return nil, err
+	}
+	result.Seed = dice.FormatSeed(seed)
+
+	logger.GetLogger().Infof("Rolled %s for %d with seed %s", result.Notation, result.Total, result.Seed)
+	return result, nil
+}
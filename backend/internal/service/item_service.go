@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/yourusername/dnd-character-creator/internal/dice"
+	"github.com/yourusername/dnd-character-creator/internal/logger"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/repository"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// ErrItemNotFound is returned when no homebrew item has the given ID
+var ErrItemNotFound = errors.New("item not found")
+
+// ItemService handles business logic for homebrew items. Characters reference
+// homebrew items by ID, so a change to an item reaches every character
+// holding it.
+type ItemService struct {
+	repo repository.ItemRepository
+}
+
+// NewItemService creates a new homebrew item service
+func NewItemService(repo repository.ItemRepository) *ItemService {
+	return &ItemService{
+		repo: repo,
+	}
+}
+
+// GetAll retrieves all homebrew items with optional filtering
+func (s *ItemService) GetAll(ctx context.Context, filter repository.ItemFilter) ([]models.Item, error) {
+	logger.GetLogger().Info("Fetching homebrew items with filter")
+
+	items, err := s.repo.FindAll(ctx, filter)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to fetch items")
+		return nil, &storageError{"fetch items", err}
+	}
+
+	return items, nil
+}
+
+// GetByID retrieves a homebrew item by ID
+func (s *ItemService) GetByID(ctx context.Context, id string) (*models.Item, error) {
+	logger.GetLogger().Infof("Fetching homebrew item with ID: %s", id)
+
+	item, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to fetch item")
+		return nil, &storageError{"fetch item", err}
+	}
+
+	return item, nil
+}
+
+// Create creates a new homebrew item
+func (s *ItemService) Create(ctx context.Context, item *models.Item) (*models.Item, error) {
+	logger.GetLogger().Infof("Creating homebrew item: %s", item.Name)
+
+	item.Source = rules.ItemSourceHomebrew
+	if err := validateItem(item); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, item); err != nil {
+		logger.GetLogger().WithError(err).Error("Failed to create item")
+		return nil, &storageError{"create item", err}
+	}
+
+	return item, nil
+}
+
+// Update replaces a homebrew item
+func (s *ItemService) Update(ctx context.Context, id string, item *models.Item) (*models.Item, error) {
+	logger.GetLogger().Infof("Updating homebrew item with ID: %s", id)
+
+	item.Source = rules.ItemSourceHomebrew
+	if err := validateItem(item); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, id, item); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrItemNotFound
+		}
+		logger.GetLogger().WithError(err).Error("Failed to update item")
+		return nil, &storageError{"update item", err}
+	}
+
+	return item, nil
+}
+
+// Delete deletes a homebrew item. Characters still holding it keep the entry
+// without stats until it is removed from their inventory.
+func (s *ItemService) Delete(ctx context.Context, id string) error {
+	logger.GetLogger().Infof("Deleting homebrew item with ID: %s", id)
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return ErrItemNotFound
+		}
+		logger.GetLogger().WithError(err).Error("Failed to delete item")
+		return &storageError{"delete item", err}
+	}
+
+	return nil
+}
+
+// validateItem checks that an item's stats are usable by the rules engine
+func validateItem(item *models.Item) error {
+	var validationErrors []string
+
+	if item.Category == "armor" && item.ArmorCategory == "" {
+		validationErrors = append(validationErrors, "armorCategory is required for armor")
+	}
+	if item.Category != "armor" && item.ArmorCategory != "" {
+		validationErrors = append(validationErrors, "armorCategory is only allowed for armor")
+	}
+
+	if item.Damage != "" {
+		if _, err := dice.Parse(item.Damage); err != nil {
+			validationErrors = append(validationErrors, fmt.Sprintf("damage: %v", err))
+		}
+	}
+	if item.VersatileDamage != "" {
+		if _, err := dice.Parse(item.VersatileDamage); err != nil {
+			validationErrors = append(validationErrors, fmt.Sprintf("versatileDamage: %v", err))
+		}
+	}
+
+	if item.DamageType != "" && !rules.IsDamageType(item.DamageType) {
+		validationErrors = append(validationErrors, fmt.Sprintf("damageType %q is not a damage type", item.DamageType))
+	}
+
+	if item.Container != nil && item.Container.Weightless && item.Magic == nil {
+		validationErrors = append(validationErrors, "container.weightless is only allowed for magic items")
+	}
+
+	if item.Magic != nil {
+		validationErrors = append(validationErrors, validateMagicItem(item.Magic)...)
+	}
+
+	if len(validationErrors) > 0 {
+		logger.GetLogger().Warnf("Validation errors for item: %v", validationErrors)
+		return fmt.Errorf("validation errors: %v", validationErrors)
+	}
+	return nil
+}
+
+// validateMagicItem checks that a magic item's charges and modifiers can be
+// applied
+func validateMagicItem(magic *models.MagicItem) []string {
+	var validationErrors []string
+
+	if magic.Prerequisites != nil && !magic.RequiresAttunement {
+		validationErrors = append(validationErrors, "magic.prerequisites are only allowed for items that require attunement")
+	}
+
+	if charges := magic.Charges; charges != nil && charges.Regain != "" {
+		if charges.Recharge != rules.RechargeDawn {
+			validationErrors = append(validationErrors, "magic.charges.regain is only allowed for charges that recharge at dawn")
+		}
+		if _, err := dice.Parse(charges.Regain); err != nil {
+			validationErrors = append(validationErrors, fmt.Sprintf("magic.charges.regain: %v", err))
+		}
+	}
+
+	for i, modifier := range magic.Modifiers {
+		if !rules.IsModifierTarget(modifier.Target) {
+			validationErrors = append(validationErrors, fmt.Sprintf("magic.modifiers[%d] %q is not a stat an item can modify", i, modifier.Target))
+			continue
+		}
+		if modifier.Operation == rules.ModifierSet && !rules.IsAbility(modifier.Target) {
+			validationErrors = append(validationErrors, fmt.Sprintf("magic.modifiers[%d] can only set ability scores", i))
+		}
+	}
+
+	return validationErrors
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/yourusername/dnd-character-creator/internal/logger"
+	"github.com/yourusername/dnd-character-creator/internal/reference"
+)
+
+// ReferenceService provides read-only access to the SRD reference catalog
+type ReferenceService struct {
+	catalog *reference.Catalog
+}
+
+// NewReferenceService creates a new reference service
+func NewReferenceService(catalog *reference.Catalog) *ReferenceService {
+	return &ReferenceService{
+		catalog: catalog,
+	}
+}
+
+// Kinds returns every kind of reference data available
+func (s *ReferenceService) Kinds() []reference.Kind {
+	return reference.Kinds
+}
+
+// List retrieves reference entries of a kind with optional filtering
+func (s *ReferenceService) List(kind string, query reference.Query) ([]interface{}, error) {
+	logger.GetLogger().Infof("Fetching %s reference data", kind)
+
+	k, err := reference.ParseKind(kind)
+	if err != nil {
+		logger.GetLogger().Warnf("Unknown reference kind: %s", kind)
+		return nil, err
+	}
+
+	entries, err := s.catalog.List(k, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", kind, err)
+	}
+
+	logger.GetLogger().Infof("Found %d %s entries", len(entries), kind)
+	return entries, nil
+}
+
+// Get retrieves a single reference entry by ID or name
+func (s *ReferenceService) Get(kind string, id string) (interface{}, error) {
+	logger.GetLogger().Infof("Fetching %s reference entry: %s", kind, id)
+
+	k, err := reference.ParseKind(kind)
+	if err != nil {
+		logger.GetLogger().Warnf("Unknown reference kind: %s", kind)
+		return nil, err
+	}
+
+	entry, err := s.catalog.Get(k, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s entry: %w", kind, err)
+	}
+
+	if entry == nil {
+		logger.GetLogger().Warnf("Reference entry not found: %s/%s", kind, id)
+		return nil, nil
+	}
+
+	return entry, nil
+}
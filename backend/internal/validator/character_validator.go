@@ -5,14 +5,62 @@ import (
 	"strings"
 
 	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/reference"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
 )
 
+// Mode selects how violations of the reference catalog rules are treated
+type Mode string
+
+const (
+	// ModeStrict rejects characters that break catalog rules
+	ModeStrict Mode = "strict"
+	// ModeLenient accepts any character and reports rule violations as warnings
+	ModeLenient Mode = "lenient"
+)
+
+// ParseMode converts a configuration value into a Mode, defaulting to strict
+func ParseMode(value string) Mode {
+	if strings.EqualFold(strings.TrimSpace(value), string(ModeLenient)) {
+		return ModeLenient
+	}
+	return ModeStrict
+}
+
 // CharacterValidator validates character data
-type CharacterValidator struct{}
+type CharacterValidator struct {
+	catalog *reference.Catalog
+	mode    Mode
+	// methods lists the accepted ability score methods; empty accepts all
+	methods []string
+}
 
 // NewCharacterValidator creates a new character validator
 func NewCharacterValidator() *CharacterValidator {
-	return &CharacterValidator{}
+	return &CharacterValidator{
+		mode: ModeLenient,
+	}
+}
+
+// NewRulesValidator creates a character validator that also checks
+// characters against the reference catalog in the given mode
+func NewRulesValidator(catalog *reference.Catalog, mode Mode) *CharacterValidator {
+	return &CharacterValidator{
+		catalog: catalog,
+		mode:    mode,
+	}
+}
+
+// RestrictMethods limits the ability score methods the catalog rules accept.
+// Scores without base scores count as manual.
+func (v *CharacterValidator) RestrictMethods(methods []string) *CharacterValidator {
+	v.methods = methods
+	return v
+}
+
+// Mode returns the validation mode
+func (v *CharacterValidator) Mode() Mode {
+	return v.mode
 }
 
 // Validate validates a character
@@ -65,6 +113,15 @@ func (v *CharacterValidator) Validate(character *models.Character) []string {
 		}
 	}
 
+	// Validate that class levels add up to the total level
+	primaryLevel := rules.PrimaryClassLevel(character)
+	multiclassLevels := rules.MulticlassLevels(character)
+	if primaryLevel < 1 {
+		errors = append(errors, fmt.Sprintf("multiclass levels (%d) leave no levels in class for total level %d", multiclassLevels, character.Level))
+	} else if primaryLevel+multiclassLevels != character.Level {
+		errors = append(errors, fmt.Sprintf("level %d must equal the sum of class levels (%d)", character.Level, primaryLevel+multiclassLevels))
+	}
+
 	// Validate inventory
 	if character.Inventory != nil {
 		errors = append(errors, v.validateInventory(character.Inventory)...)
@@ -78,11 +135,28 @@ func (v *CharacterValidator) Validate(character *models.Character) []string {
 	// Validate features
 	errors = append(errors, v.validateFeatures(character.Features)...)
 
+	// Validate conditions
+	for i, condition := range character.Conditions {
+		if !rules.IsCondition(condition.Name) || strings.EqualFold(condition.Name, rules.ConditionExhaustion) {
+			errors = append(errors, fmt.Sprintf("conditions[%d] %q is not a condition", i, condition.Name))
+		}
+	}
+
+	// Validate damage types
+	errors = append(errors, validateDamageTypes("damageResistances", character.DamageResistances)...)
+	errors = append(errors, validateDamageTypes("damageImmunities", character.DamageImmunities)...)
+	errors = append(errors, validateDamageTypes("damageVulnerabilities", character.DamageVulnerabilities)...)
+
 	// Validate appearance
 	if character.Appearance != nil {
 		errors = append(errors, v.validateAppearance(character.Appearance)...)
 	}
 
+	// Validate against reference catalog rules
+	if v.mode == ModeStrict {
+		errors = append(errors, v.ValidateRules(character)...)
+	}
+
 	return errors
 }
 
@@ -98,12 +172,48 @@ func (v *CharacterValidator) validateAbilityScores(scores *models.AbilityScores)
 		"charisma":     scores.Charisma,
 	}
 
+	layered := rules.HasBaseScores(scores)
 	for name, abilityScore := range abilities {
-		if abilityScore.Score < 1 || abilityScore.Score > 30 {
+		if layered {
+			if abilityScore.Base < 1 || abilityScore.Base > 30 {
+				errors = append(errors, fmt.Sprintf("%s base score must be between 1 and 30", name))
+			}
+		} else if abilityScore.Score < 1 || abilityScore.Score > 30 {
 			errors = append(errors, fmt.Sprintf("%s must be between 1 and 30", name))
 		}
 	}
 
+	for i, increase := range scores.Increases {
+		if rules.CanonicalAbility(increase.Ability) == "" {
+			errors = append(errors, fmt.Sprintf("abilityScores.increases[%d].ability %q is not an ability", i, increase.Ability))
+		}
+		if increase.Amount < 1 || increase.Amount > 2 {
+			errors = append(errors, fmt.Sprintf("abilityScores.increases[%d].amount must be 1 or 2", i))
+		}
+	}
+
+	for i, override := range scores.Overrides {
+		if rules.CanonicalAbility(override.Ability) == "" {
+			errors = append(errors, fmt.Sprintf("abilityScores.overrides[%d].ability %q is not an ability", i, override.Ability))
+		}
+		if override.Score < 1 || override.Score > 30 {
+			errors = append(errors, fmt.Sprintf("abilityScores.overrides[%d].score must be between 1 and 30", i))
+		}
+	}
+
+	if (len(scores.Increases) > 0 || len(scores.Overrides) > 0) && !layered {
+		errors = append(errors, "ability score increases and overrides require base scores")
+	}
+
+	// Base scores are only trusted along with the method that produced them
+	if layered && scores.Method == "" {
+		errors = append(errors, "abilityScores.method is required with base scores")
+	} else if layered {
+		for _, err := range rules.ValidateAbilityScores(scores.Method, rules.BaseScoreValues(scores), scores.Seed) {
+			errors = append(errors, "abilityScores.base: "+err)
+		}
+	}
+
 	return errors
 }
 
@@ -138,6 +248,9 @@ func (v *CharacterValidator) validateInventory(inventory *models.Inventory) []st
 		}
 	}
 
+	errors = append(errors, rules.EquipmentErrors(v.catalog, inventory)...)
+	errors = append(errors, rules.ContainerErrors(v.catalog, inventory)...)
+
 	for i, armor := range inventory.Armor {
 		if armor.Name == "" {
 			errors = append(errors, fmt.Sprintf("inventory.armor[%d].name is required", i))
@@ -206,6 +319,18 @@ func (v *CharacterValidator) validateFeatures(features []models.Feature) []strin
 	return errors
 }
 
+func validateDamageTypes(field string, damageTypes []string) []string {
+	var errors []string
+
+	for i, damageType := range damageTypes {
+		if !rules.IsDamageType(damageType) {
+			errors = append(errors, fmt.Sprintf("%s[%d] %q is not a damage type", field, i, damageType))
+		}
+	}
+
+	return errors
+}
+
 func (v *CharacterValidator) validateAppearance(appearance *models.Appearance) []string {
 	var errors []string
 
@@ -0,0 +1,372 @@
+package validator
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/reference"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
+)
+
+// classEntry is a single class a character has levels in
+type classEntry struct {
+	field    string
+	class    string
+	subclass string
+	level    int
+}
+
+// ValidateRules checks a character against the reference catalog and returns
+// every rule violation found. It returns nothing when no catalog is configured.
+func (v *CharacterValidator) ValidateRules(character *models.Character) []string {
+	if v.catalog == nil {
+		return nil
+	}
+
+	var errors []string
+
+	errors = append(errors, v.validateRace(character)...)
+	errors = append(errors, v.validateClasses(character)...)
+
+	// Natural armor comes from the race and subclass entries in the catalog
+	if effects := character.ArmorClassEffects; effects != nil && effects.NaturalArmor > 0 {
+		errors = append(errors, "armorClassEffects.naturalArmor is derived from the reference catalog and cannot be set")
+	}
+
+	errors = append(errors, v.validateAbilityScoreMethod(character)...)
+	errors = append(errors, v.validateAbilityScoreIncreases(character)...)
+	errors = append(errors, v.validateHitPointHistory(character)...)
+
+	if character.Spellcasting != nil {
+		errors = append(errors, v.validateSpellLists(character)...)
+	}
+
+	return errors
+}
+
+// Warnings returns rule problems that are logged but never rejected: spells
+// from outside the character's class lists, magic items that cannot change
+// scores without base scores, and in lenient mode every catalog rule violation
+// as well
+func (v *CharacterValidator) Warnings(character *models.Character) []string {
+	if v.catalog == nil {
+		return nil
+	}
+
+	var warnings []string
+	if v.mode == ModeLenient {
+		warnings = append(warnings, v.ValidateRules(character)...)
+	}
+	if character.Spellcasting != nil {
+		warnings = append(warnings, v.spellListWarnings(character)...)
+	}
+	warnings = append(warnings, rules.SkippedAbilityModifiers(v.catalog, character)...)
+	return warnings
+}
+
+func (v *CharacterValidator) validateRace(character *models.Character) []string {
+	var errors []string
+
+	race, ok := v.catalog.Race(character.Race)
+	if !ok {
+		errors = append(errors, fmt.Sprintf("race %q is not in the reference catalog", character.Race))
+	}
+
+	if character.Subrace == "" {
+		return errors
+	}
+
+	subrace, found := v.catalog.Subrace(character.Subrace)
+	if !found {
+		errors = append(errors, fmt.Sprintf("subrace %q is not in the reference catalog", character.Subrace))
+	} else if ok && subrace.Race != race.ID {
+		errors = append(errors, fmt.Sprintf("subrace %q does not belong to race %q", character.Subrace, character.Race))
+	}
+
+	return errors
+}
+
+func (v *CharacterValidator) validateClasses(character *models.Character) []string {
+	var errors []string
+
+	entries := classEntries(character)
+	multiclassing := len(entries) > 1
+
+	for _, entry := range entries {
+		class, ok := v.catalog.Class(entry.class)
+		if !ok {
+			errors = append(errors, fmt.Sprintf("%s %q is not in the reference catalog", entry.field, entry.class))
+			continue
+		}
+
+		if entry.subclass != "" {
+			subclass, found := v.catalog.Subclass(entry.subclass)
+			switch {
+			case !found:
+				errors = append(errors, fmt.Sprintf("subclass %q is not in the reference catalog", entry.subclass))
+			case subclass.Class != class.ID:
+				errors = append(errors, fmt.Sprintf("subclass %q does not belong to class %q", entry.subclass, class.Name))
+			case entry.level < subclass.Level:
+				errors = append(errors, fmt.Sprintf("subclass %q is not available until %s level %d", entry.subclass, class.Name, subclass.Level))
+			}
+		}
+
+		if multiclassing && !rules.MeetsMulticlassPrerequisites(&character.AbilityScores, class.MulticlassPrerequisites) {
+			errors = append(errors, fmt.Sprintf("%s %q requires %s to multiclass", entry.field, class.Name, rules.DescribeMulticlassPrerequisites(class.MulticlassPrerequisites)))
+		}
+	}
+
+	return errors
+}
+
+func (v *CharacterValidator) validateSpellLists(character *models.Character) []string {
+	var errors []string
+
+	check := func(field string, spells []string, cantrips bool) {
+		for i, name := range spells {
+			spell, ok := v.catalog.Spell(name)
+			if !ok {
+				errors = append(errors, fmt.Sprintf("spellcasting.%s[%d] %q is not in the reference catalog", field, i, name))
+				continue
+			}
+
+			if cantrips && spell.Level != 0 {
+				errors = append(errors, fmt.Sprintf("spellcasting.%s[%d] %q is not a cantrip", field, i, name))
+			} else if !cantrips && spell.Level == 0 {
+				errors = append(errors, fmt.Sprintf("spellcasting.%s[%d] %q is a cantrip", field, i, name))
+			}
+
+		}
+	}
+
+	check("cantripsKnown", character.Spellcasting.CantripsKnown, true)
+	check("spellsKnown", character.Spellcasting.SpellsKnown, false)
+	check("preparedSpells", character.Spellcasting.PreparedSpells, false)
+
+	return errors
+}
+
+// spellListWarnings reports spells that are not on any of the character's
+// class spell lists. Subclass and domain spells, racial spells, feats and
+// Magical Secrets all grant such spells, so they are never rejected.
+func (v *CharacterValidator) spellListWarnings(character *models.Character) []string {
+	var warnings []string
+
+	var classIDs []string
+	var classNames []string
+	for _, entry := range classEntries(character) {
+		if class, ok := v.catalog.Class(entry.class); ok {
+			classIDs = append(classIDs, class.ID)
+			classNames = append(classNames, class.Name)
+		}
+	}
+	if len(classIDs) == 0 {
+		return nil
+	}
+
+	check := func(field string, spells []string) {
+		for i, name := range spells {
+			if spell, ok := v.catalog.Spell(name); ok && !onSpellList(spell, classIDs) {
+				warnings = append(warnings, fmt.Sprintf("spellcasting.%s[%d] %q is not on the spell list for %s", field, i, name, strings.Join(classNames, "/")))
+			}
+		}
+	}
+
+	check("cantripsKnown", character.Spellcasting.CantripsKnown)
+	check("spellsKnown", character.Spellcasting.SpellsKnown)
+	check("preparedSpells", character.Spellcasting.PreparedSpells)
+
+	return warnings
+}
+
+// validateAbilityScoreMethod rejects methods the server does not allow, so a
+// table can require rolled, point-buy or standard array scores
+func (v *CharacterValidator) validateAbilityScoreMethod(character *models.Character) []string {
+	if len(v.methods) == 0 {
+		return nil
+	}
+
+	scores := &character.AbilityScores
+	method := scores.Method
+	if !rules.HasBaseScores(scores) {
+		method = rules.MethodManual
+	}
+	if slices.Contains(v.methods, method) {
+		return nil
+	}
+	return []string{fmt.Sprintf("abilityScores.method %q is not allowed, use one of %s", method, strings.Join(v.methods, ", "))}
+}
+
+func (v *CharacterValidator) validateAbilityScoreIncreases(character *models.Character) []string {
+	var errors []string
+
+	scores := &character.AbilityScores
+	version := rules.RulesVersion(scores)
+
+	var racial, background []int
+	levelUp := 0
+	for i, increase := range scores.Increases {
+		switch increase.Source {
+		case rules.IncreaseRace:
+			racial = append(racial, i)
+		case rules.IncreaseBackground:
+			background = append(background, i)
+		case rules.IncreaseLevelUp:
+			levelUp += increase.Amount
+		}
+	}
+
+	if version == rules.Rules2024 && len(racial) > 0 {
+		errors = append(errors, "racial ability score increases are not used with the 2024 rules")
+	}
+	if version == rules.Rules2014 && len(background) > 0 {
+		errors = append(errors, "background ability score increases require the 2024 rules")
+	}
+
+	if version == rules.Rules2014 && len(racial) > 0 {
+		errors = append(errors, v.validateRacialChoices(character, racial)...)
+	}
+	if version == rules.Rules2024 && len(background) > 0 {
+		errors = append(errors, v.validateBackgroundIncreases(character, background)...)
+	}
+
+	available := 0
+	for _, entry := range classEntries(character) {
+		if class, ok := v.catalog.Class(entry.class); ok {
+			available += rules.AbilityScoreImprovements(class.ID, entry.level)
+		}
+	}
+	if levelUp > 2*available {
+		errors = append(errors, fmt.Sprintf("level-up ability score increases total %d but only %d Ability Score Improvements are available", levelUp, available))
+	}
+
+	return errors
+}
+
+// validateRacialChoices checks the freely chosen racial increases, such as the
+// Half-Elf's two +1 increases, against the race's choice rules
+func (v *CharacterValidator) validateRacialChoices(character *models.Character, indexes []int) []string {
+	race, ok := v.catalog.Race(character.Race)
+	if !ok {
+		return nil
+	}
+
+	choice := race.AbilityBonusChoice
+	if choice == nil {
+		return []string{fmt.Sprintf("race %q has no ability score increases to choose", race.Name)}
+	}
+
+	var errors []string
+	if len(indexes) > choice.Count {
+		errors = append(errors, fmt.Sprintf("race %q allows %d chosen ability score increases, got %d", race.Name, choice.Count, len(indexes)))
+	}
+
+	chosen := make(map[string]bool)
+	for _, i := range indexes {
+		increase := character.AbilityScores.Increases[i]
+		ability := rules.CanonicalAbility(increase.Ability)
+		if increase.Amount != choice.Amount {
+			errors = append(errors, fmt.Sprintf("abilityScores.increases[%d] must increase %s by %d", i, ability, choice.Amount))
+		}
+		for _, excluded := range choice.Exclude {
+			if ability == excluded {
+				errors = append(errors, fmt.Sprintf("abilityScores.increases[%d] %s cannot be chosen for race %q", i, ability, race.Name))
+			}
+		}
+		if chosen[ability] {
+			errors = append(errors, fmt.Sprintf("abilityScores.increases[%d] %s is chosen more than once", i, ability))
+		}
+		chosen[ability] = true
+	}
+
+	return errors
+}
+
+// validateBackgroundIncreases checks the 2024 background increases: either +2
+// and +1 or three +1 increases, to abilities the background lists
+func (v *CharacterValidator) validateBackgroundIncreases(character *models.Character, indexes []int) []string {
+	var errors []string
+
+	var allowed []string
+	if background, ok := v.catalog.Background(character.Background); ok {
+		allowed = background.AbilityScores
+	}
+
+	total := 0
+	perAbility := make(map[string]int)
+	for _, i := range indexes {
+		increase := character.AbilityScores.Increases[i]
+		ability := rules.CanonicalAbility(increase.Ability)
+		total += increase.Amount
+		perAbility[ability] += increase.Amount
+
+		if len(allowed) > 0 && !slices.Contains(allowed, ability) {
+			errors = append(errors, fmt.Sprintf("abilityScores.increases[%d] %s is not an ability of background %q", i, ability, character.Background))
+		}
+	}
+
+	if total != 3 {
+		errors = append(errors, fmt.Sprintf("background ability score increases must total 3, got %d", total))
+	}
+	for _, ability := range rules.Abilities {
+		if perAbility[ability] > 2 {
+			errors = append(errors, fmt.Sprintf("background increases raise %s by more than 2", ability))
+		}
+	}
+
+	return errors
+}
+
+func (v *CharacterValidator) validateHitPointHistory(character *models.Character) []string {
+	var errors []string
+
+	for i, entry := range character.HitPoints.History {
+		if entry.Method != rules.HitPointRolled {
+			continue
+		}
+		class, ok := v.catalog.Class(entry.Class)
+		if !ok {
+			errors = append(errors, fmt.Sprintf("hitPoints.history[%d] class %q is not in the reference catalog", i, entry.Class))
+			continue
+		}
+		if entry.Roll < 1 || entry.Roll > class.HitDie {
+			errors = append(errors, fmt.Sprintf("hitPoints.history[%d] roll %d is not possible on a d%d", i, entry.Roll, class.HitDie))
+		}
+	}
+
+	return errors
+}
+
+// classEntries lists the primary class and every multiclass entry along with
+// the field name used in error messages
+func classEntries(character *models.Character) []classEntry {
+	var entries []classEntry
+
+	for i, cl := range rules.ClassLevels(character) {
+		field := "class"
+		if i > 0 {
+			field = fmt.Sprintf("multiclass[%d].class", i-1)
+		}
+		entries = append(entries, classEntry{
+			field:    field,
+			class:    cl.Class,
+			subclass: cl.Subclass,
+			level:    cl.Level,
+		})
+	}
+
+	return entries
+}
+
+func onSpellList(spell *reference.Spell, classIDs []string) bool {
+	for _, spellClass := range spell.Classes {
+		for _, id := range classIDs {
+			if spellClass == id {
+				return true
+			}
+		}
+	}
+	return false
+}
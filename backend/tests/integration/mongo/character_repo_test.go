@@ -0,0 +1,84 @@
+package mongo_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/repository"
+	repomongo "github.com/yourusername/dnd-character-creator/internal/repository/mongo"
+)
+
+// newCharacterRepository connects to the MongoDB named by MONGODB_TEST_URI
+// and returns a repository backed by a database dropped when the test ends.
+// Tests are skipped when the variable isn't set.
+func newCharacterRepository(t *testing.T) repository.CharacterRepository {
+	uri := os.Getenv("MONGODB_TEST_URI")
+	if uri == "" {
+		t.Skip("MONGODB_TEST_URI is not set")
+	}
+
+	client, err := repomongo.Connect(uri, 10*time.Second)
+	require.NoError(t, err)
+
+	database := fmt.Sprintf("dnd_test_%d", time.Now().UnixNano())
+	t.Cleanup(func() {
+		_ = client.Database(database).Drop(context.Background())
+		_ = repomongo.Disconnect(client)
+	})
+
+	return repomongo.NewCharacterRepository(client, database)
+}
+
+func TestCharacterRepository_Update_ClearsEmptiedFields(t *testing.T) {
+	repo := newCharacterRepository(t)
+	ctx := context.Background()
+
+	character := &models.Character{
+		CharacterName: "Poisoned",
+		Race:          "Human",
+		Class:         "Fighter",
+		Level:         1,
+		Conditions:    []models.Condition{{Name: "poisoned"}},
+		Exhaustion:    1,
+	}
+	require.NoError(t, repo.Create(ctx, character))
+	id := character.ID
+
+	stored, err := repo.FindByID(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, stored.Conditions, 1)
+
+	stored.Conditions = nil
+	stored.Exhaustion = 0
+	require.NoError(t, repo.Update(ctx, id, stored))
+	assert.Equal(t, id, stored.ID)
+
+	reread, err := repo.FindByID(ctx, id)
+	require.NoError(t, err)
+	assert.Empty(t, reread.Conditions)
+	assert.Zero(t, reread.Exhaustion)
+	assert.Equal(t, 2, reread.Version)
+	assert.Equal(t, id, reread.ID)
+}
+
+func TestCharacterRepository_Update_VersionConflict(t *testing.T) {
+	repo := newCharacterRepository(t)
+	ctx := context.Background()
+
+	character := &models.Character{CharacterName: "Contested", Race: "Human", Class: "Fighter", Level: 1}
+	require.NoError(t, repo.Create(ctx, character))
+
+	first, err := repo.FindByID(ctx, character.ID)
+	require.NoError(t, err)
+	second, err := repo.FindByID(ctx, character.ID)
+	require.NoError(t, err)
+
+	require.NoError(t, repo.Update(ctx, character.ID, first))
+	assert.ErrorIs(t, repo.Update(ctx, character.ID, second), repository.ErrVersionConflict)
+}
@@ -21,6 +21,10 @@ func TestLoad_DefaultValues(t *testing.T) {
 	assert.Equal(t, "debug", cfg.Logging.Level)
 	assert.Equal(t, "json", cfg.Logging.Format)
 	assert.Equal(t, 500, cfg.App.MaxStringLength)
+	assert.Equal(t, "strict", cfg.Rules.ValidationMode)
+	assert.False(t, cfg.Rules.VariantEncumbrance)
+	assert.Equal(t, "xp", cfg.Rules.AdvancementMode)
+	assert.Empty(t, cfg.Rules.AbilityScoreMethods)
 }
 
 func TestLoad_CustomValues(t *testing.T) {
@@ -31,6 +35,10 @@ func TestLoad_CustomValues(t *testing.T) {
 	os.Setenv("LOG_LEVEL", "info")
 	os.Setenv("LOG_FORMAT", "text")
 	os.Setenv("MAX_STRING_LENGTH", "1000")
+	os.Setenv("VALIDATION_MODE", "lenient")
+	os.Setenv("VARIANT_ENCUMBRANCE", "true")
+	os.Setenv("ADVANCEMENT_MODE", "milestone")
+	os.Setenv("ABILITY_SCORE_METHODS", "pointBuy,rolled")
 	defer os.Clearenv()
 
 	cfg := config.Load()
@@ -42,6 +50,10 @@ func TestLoad_CustomValues(t *testing.T) {
 	assert.Equal(t, "info", cfg.Logging.Level)
 	assert.Equal(t, "text", cfg.Logging.Format)
 	assert.Equal(t, 1000, cfg.App.MaxStringLength)
+	assert.Equal(t, "lenient", cfg.Rules.ValidationMode)
+	assert.True(t, cfg.Rules.VariantEncumbrance)
+	assert.Equal(t, "milestone", cfg.Rules.AdvancementMode)
+	assert.Equal(t, []string{"pointBuy", "rolled"}, cfg.Rules.AbilityScoreMethods)
 }
 
 func TestLoad_CORSConfiguration(t *testing.T) {
@@ -0,0 +1,140 @@
+package dice_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/dice"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		notation string
+		expected string
+	}{
+		{"1d8+3", "1d8+3"},
+		{"d20", "1d20"},
+		{" 2d6 + 1d4 - 1 ", "2d6+1d4-1"},
+		{"4d6dl1", "4d6dl1"},
+		{"2d20KH1", "2d20kh1"},
+		{"1d6!", "1d6!"},
+		{"3d6!kl2", "3d6!kl2"},
+		{"d%", "1d100"},
+		{"-2", "-2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.notation, func(t *testing.T) {
+			expression, err := dice.Parse(tt.notation)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, expression.String())
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, notation := range []string{"", "1d", "d", "1d8+", "0d6", "101d6", "1d1001", "1d1!", "4d6x1", "4d6dl", "fireball"} {
+		t.Run(notation, func(t *testing.T) {
+			_, err := dice.Parse(notation)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRoll_Deterministic(t *testing.T) {
+	first, err := dice.Roll(dice.NewRoller(42).Die, "4d6dl1+2", "")
+	require.NoError(t, err)
+	second, err := dice.Roll(dice.NewRoller(42).Die, "4d6dl1+2", "")
+	require.NoError(t, err)
+
+	assert.Equal(t, first, second)
+}
+
+func TestRoll_KeepAndDrop(t *testing.T) {
+	roll := dice.NewRoller(7).Die
+
+	for range 50 {
+		result, err := dice.Roll(roll, "4d6dl1+2", "")
+		require.NoError(t, err)
+		require.Len(t, result.Terms, 2)
+
+		group := result.Terms[0]
+		require.Len(t, group.Dice, 4)
+
+		lowest := 6
+		for _, die := range group.Dice {
+			assert.Equal(t, 6, die.Sides)
+			assert.GreaterOrEqual(t, die.Value, 1)
+			lowest = min(lowest, die.Value)
+		}
+
+		dropped, kept := 0, 0
+		for _, die := range group.Dice {
+			if die.Dropped {
+				dropped++
+				assert.Equal(t, lowest, die.Value)
+			} else {
+				kept += die.Value
+			}
+		}
+		assert.Equal(t, 1, dropped)
+		assert.Equal(t, kept, group.Total)
+		assert.Equal(t, models.RollTerm{Notation: "2", Sign: 1, Total: 2}, result.Terms[1])
+		assert.Equal(t, kept+2, result.Total)
+	}
+}
+
+func TestRoll_Subtraction(t *testing.T) {
+	result, err := dice.Roll(dice.NewRoller(1).Die, "1d4-1d4-3", "")
+	require.NoError(t, err)
+
+	assert.Equal(t, -1, result.Terms[1].Sign)
+	assert.Equal(t, result.Terms[0].Total-result.Terms[1].Total-3, result.Total)
+}
+
+func TestRoll_Exploding(t *testing.T) {
+	roll := dice.NewRoller(3).Die
+
+	exploded := false
+	for range 100 {
+		result, err := dice.Roll(roll, "1d4!", "")
+		require.NoError(t, err)
+
+		rolled := result.Terms[0].Dice
+		for i, die := range rolled {
+			// Every die but the last rolled the maximum and exploded
+			last := i == len(rolled)-1
+			assert.Equal(t, !last, die.Exploded)
+			if die.Exploded {
+				assert.Equal(t, 4, die.Value)
+				exploded = true
+			}
+		}
+	}
+	assert.True(t, exploded)
+}
+
+func TestRoll_AdvantageAndDisadvantage(t *testing.T) {
+	roll := dice.NewRoller(9).Die
+
+	result, err := dice.Roll(roll, "1d20+5", dice.Advantage)
+	require.NoError(t, err)
+	assert.Equal(t, "2d20kh1+5", result.Notation)
+	rolled := result.Terms[0].Dice
+	require.Len(t, rolled, 2)
+	assert.Equal(t, max(rolled[0].Value, rolled[1].Value)+5, result.Total)
+
+	result, err = dice.Roll(roll, "d20", dice.Disadvantage)
+	require.NoError(t, err)
+	assert.Equal(t, "2d20kl1", result.Notation)
+	rolled = result.Terms[0].Dice
+	assert.Equal(t, min(rolled[0].Value, rolled[1].Value), result.Total)
+
+	_, err = dice.Roll(roll, "1d8+3", dice.Advantage)
+	assert.EqualError(t, err, "advantage needs a roll with a single d20")
+
+	_, err = dice.Roll(roll, "1d20", "lucky")
+	assert.EqualError(t, err, `unknown roll mode "lucky"`)
+}
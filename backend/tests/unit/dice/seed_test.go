@@ -0,0 +1,36 @@
+package dice_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/dice"
+)
+
+func TestParseSeed_RoundTrip(t *testing.T) {
+	seed, err := dice.ParseSeed(dice.FormatSeed(0xdeadbeef))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0xdeadbeef), seed)
+
+	_, err = dice.ParseSeed("not-a-seed")
+	assert.Error(t, err)
+}
+
+func TestSignSeed(t *testing.T) {
+	secret := []byte("secret")
+	signed := dice.SignSeed(secret, 7)
+
+	seed, err := dice.ParseSeed(signed)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), seed)
+
+	assert.NoError(t, dice.VerifySeed(secret, signed))
+	assert.Error(t, dice.VerifySeed([]byte("other"), signed))
+	assert.Error(t, dice.VerifySeed(nil, signed))
+	assert.Error(t, dice.VerifySeed(secret, dice.FormatSeed(7)))
+
+	// Keeping the signature but swapping the seed is caught
+	forged := dice.FormatSeed(8) + signed[len(dice.FormatSeed(7)):]
+	assert.Error(t, dice.VerifySeed(secret, forged))
+}
@@ -0,0 +1,206 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/config"
+	"github.com/yourusername/dnd-character-creator/internal/handler"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/reference"
+	"github.com/yourusername/dnd-character-creator/internal/repository"
+	"github.com/yourusername/dnd-character-creator/internal/service"
+)
+
+// MockCharacterRepository mocks the repository
+type MockCharacterRepository struct {
+	mock.Mock
+}
+
+func (m *MockCharacterRepository) FindAll(ctx context.Context, filter repository.CharacterFilter) ([]models.Character, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]models.Character), args.Error(1)
+}
+
+func (m *MockCharacterRepository) FindByID(ctx context.Context, id string) (*models.Character, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Character), args.Error(1)
+}
+
+func (m *MockCharacterRepository) Create(ctx context.Context, character *models.Character) error {
+	args := m.Called(ctx, character)
+	return args.Error(0)
+}
+
+func (m *MockCharacterRepository) Update(ctx context.Context, id string, character *models.Character) error {
+	args := m.Called(ctx, id, character)
+	return args.Error(0)
+}
+
+func (m *MockCharacterRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockCharacterRepository) ExistsByName(ctx context.Context, name string, excludeID string) (bool, error) {
+	args := m.Called(ctx, name, excludeID)
+	return args.Bool(0), args.Error(1)
+}
+
+// WithTransaction runs fn directly; the mock has no writes to roll back
+func (m *MockCharacterRepository) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// MockItemRepository mocks the homebrew item repository
+type MockItemRepository struct {
+	mock.Mock
+}
+
+func (m *MockItemRepository) FindAll(ctx context.Context, filter repository.ItemFilter) ([]models.Item, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]models.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) FindByID(ctx context.Context, id string) (*models.Item, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) FindByIDs(ctx context.Context, ids []string) ([]models.Item, error) {
+	args := m.Called(ctx, ids)
+	return args.Get(0).([]models.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) Create(ctx context.Context, item *models.Item) error {
+	args := m.Called(ctx, item)
+	return args.Error(0)
+}
+
+func (m *MockItemRepository) Update(ctx context.Context, id string, item *models.Item) error {
+	args := m.Called(ctx, id, item)
+	return args.Error(0)
+}
+
+func (m *MockItemRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+const characterID = "507f1f77bcf86cd799439011"
+
+// wand is a homebrew wand with seven charges
+var wand = models.Item{
+	ID: "507f1f77bcf86cd799439012", Name: "Wand of Sparks", Source: "homebrew", Category: "gear", Weight: 1,
+	Magic: &models.MagicItem{Rarity: "uncommon", Charges: &models.ItemCharges{Maximum: 7, Recharge: "dawn"}},
+}
+
+// newRouter serves the character action routes from a service backed by repo
+// and items
+func newRouter(t *testing.T, repo repository.CharacterRepository, items repository.ItemRepository) *gin.Engine {
+	catalog, err := reference.Load()
+	require.NoError(t, err)
+	svc := service.NewCharacterService(repo, items, catalog, config.RulesConfig{ValidationMode: "strict", RollSecret: "test-secret"})
+	h := handler.NewCharacterHandler(svc)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	characters := router.Group("/api/v1/characters")
+	characters.POST("/:id/resources/:name/spend", h.SpendResource)
+	characters.POST("/:id/resources/:name/restore", h.RestoreResource)
+	characters.POST("/:id/inventory/:itemId/equip", h.EquipItem)
+	characters.POST("/:id/inventory/:itemId/unequip", h.UnequipItem)
+	characters.POST("/:id/inventory/:itemId/move", h.MoveItem)
+	characters.POST("/:id/inventory/:itemId/charges/spend", h.SpendCharges)
+	characters.POST("/:id/rest/short", h.ShortRest)
+	return router
+}
+
+// storedFighter is a wounded level 2 Fighter wielding a dagger with a
+// longsword and a charged wand packed in its backpack, as the repository
+// returns it
+func storedFighter() *models.Character {
+	charges := 7
+	return &models.Character{
+		ID:            characterID,
+		CharacterName: "Tank",
+		Race:          "Human",
+		Class:         "Fighter",
+		Level:         2,
+		AbilityScores: models.AbilityScores{
+			Strength:     models.AbilityScore{Score: 16},
+			Dexterity:    models.AbilityScore{Score: 12},
+			Constitution: models.AbilityScore{Score: 14},
+			Intelligence: models.AbilityScore{Score: 10},
+			Wisdom:       models.AbilityScore{Score: 10},
+			Charisma:     models.AbilityScore{Score: 8},
+		},
+		HitPoints: models.HitPoints{Current: 5},
+		Inventory: &models.Inventory{Items: []models.InventoryItem{
+			{ID: "dagger", ItemID: "dagger", Quantity: 1, Equipped: true},
+			{ID: "pack", ItemID: "backpack", Quantity: 1},
+			{ID: "sword", ItemID: "longsword", Quantity: 1, ContainerID: "pack"},
+			{ID: "wand", ItemID: wand.ID, Quantity: 1, Charges: &charges, ContainerID: "pack"},
+		}},
+	}
+}
+
+// post sends body to path, leaving the body out entirely when it is empty
+func post(router *gin.Engine, path, body string) *httptest.ResponseRecorder {
+	var request *http.Request
+	if body == "" {
+		request = httptest.NewRequest(http.MethodPost, path, http.NoBody)
+	} else {
+		request = httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	}
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestCharacterHandler_OptionalBodies(t *testing.T) {
+	tests := []struct {
+		name   string
+		path   string
+		body   string
+		status int
+	}{
+		{name: "spend a resource without a body", path: "/resources/Second%20Wind/spend", status: http.StatusOK},
+		{name: "restore a resource without a body", path: "/resources/Second%20Wind/restore", status: http.StatusOK},
+		{name: "equip without a body", path: "/inventory/sword/equip", status: http.StatusOK},
+		{name: "unequip without a body", path: "/inventory/dagger/unequip", status: http.StatusOK},
+		{name: "unpack without a body", path: "/inventory/sword/move", status: http.StatusOK},
+		{name: "spend a charge without a body", path: "/inventory/wand/charges/spend", status: http.StatusOK},
+		{name: "short rest without a body", path: "/rest/short", status: http.StatusOK},
+		{name: "short rest with an empty object", path: "/rest/short", body: "{}", status: http.StatusOK},
+		{name: "short rest with malformed JSON", path: "/rest/short", body: "{", status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockCharacterRepository)
+			repo.On("FindByID", mock.Anything, characterID).Return(storedFighter(), nil).Maybe()
+			repo.On("Update", mock.Anything, characterID, mock.Anything).Return(nil).Maybe()
+			items := new(MockItemRepository)
+			items.On("FindByIDs", mock.Anything, mock.Anything).Return([]models.Item{wand}, nil).Maybe()
+
+			recorder := post(newRouter(t, repo, items), "/api/v1/characters/"+characterID+tt.path, tt.body)
+
+			assert.Equal(t, tt.status, recorder.Code, recorder.Body.String())
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package handler_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/yourusername/dnd-character-creator/internal/handler"
+	"github.com/yourusername/dnd-character-creator/internal/service"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestItemHandler_ErrorStatuses(t *testing.T) {
+	id := "507f1f77bcf86cd799439012"
+
+	tests := []struct {
+		name   string
+		method string
+		setup  func(repo *MockItemRepository)
+		status int
+		body   string
+	}{
+		{
+			name:   "get missing item",
+			method: http.MethodGet,
+			setup: func(repo *MockItemRepository) {
+				repo.On("FindByID", mock.Anything, id).Return(nil, nil)
+			},
+			status: http.StatusNotFound,
+			body:   `{"error":"Item not found"}`,
+		},
+		{
+			name:   "get during a storage failure",
+			method: http.MethodGet,
+			setup: func(repo *MockItemRepository) {
+				repo.On("FindByID", mock.Anything, id).Return(nil, errors.New("connection reset"))
+			},
+			status: http.StatusInternalServerError,
+			body:   `{"error":"Internal server error"}`,
+		},
+		{
+			name:   "delete missing item",
+			method: http.MethodDelete,
+			setup: func(repo *MockItemRepository) {
+				repo.On("Delete", mock.Anything, id).Return(mongo.ErrNoDocuments)
+			},
+			status: http.StatusNotFound,
+			body:   `{"error":"item not found"}`,
+		},
+		{
+			name:   "delete during a storage failure",
+			method: http.MethodDelete,
+			setup: func(repo *MockItemRepository) {
+				repo.On("Delete", mock.Anything, id).Return(errors.New("connection reset"))
+			},
+			status: http.StatusInternalServerError,
+			body:   `{"error":"Internal server error"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockItemRepository)
+			tt.setup(repo)
+			h := handler.NewItemHandler(service.NewItemService(repo))
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/api/v1/items/:id", h.GetByID)
+			router.DELETE("/api/v1/items/:id", h.Delete)
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(tt.method, "/api/v1/items/"+id, nil))
+
+			assert.Equal(t, tt.status, recorder.Code)
+			assert.JSONEq(t, tt.body, recorder.Body.String())
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package reference_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/reference"
+)
+
+func loadCatalog(t *testing.T) *reference.Catalog {
+	catalog, err := reference.Load()
+	require.NoError(t, err)
+	return catalog
+}
+
+func TestLoad_AllKindsPopulated(t *testing.T) {
+	catalog := loadCatalog(t)
+
+	for _, kind := range reference.Kinds {
+		entries, err := catalog.List(kind, reference.Query{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, entries, "kind %s should have entries", kind)
+	}
+}
+
+func TestCatalog_LookupByIDOrName(t *testing.T) {
+	catalog := loadCatalog(t)
+
+	byID, ok := catalog.Class("wizard")
+	require.True(t, ok)
+	byName, ok := catalog.Class("Wizard")
+	require.True(t, ok)
+	assert.Equal(t, byID, byName)
+	assert.Equal(t, 6, byID.HitDie)
+
+	_, ok = catalog.Class("Wizzard")
+	assert.False(t, ok)
+}
+
+func TestCatalog_ListSpellsWithFilters(t *testing.T) {
+	catalog := loadCatalog(t)
+	level := 3
+	concentration := false
+
+	entries, err := catalog.List(reference.KindSpells, reference.Query{
+		Class:         "Wizard",
+		Level:         &level,
+		Concentration: &concentration,
+		Search:        "fire",
+	})
+
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "Fireball", entries[0].(reference.Spell).Name)
+}
+
+func TestCatalog_SpellsCoverSRD(t *testing.T) {
+	catalog := loadCatalog(t)
+
+	entries, err := catalog.List(reference.KindSpells, reference.Query{})
+	require.NoError(t, err)
+	assert.Len(t, entries, 318, "the catalog should hold every SRD 5.1 spell")
+
+	counts := map[int]int{}
+	for _, entry := range entries {
+		counts[entry.(reference.Spell).Level]++
+	}
+	assert.Equal(t, map[int]int{0: 24, 1: 49, 2: 53, 3: 42, 4: 31, 5: 37, 6: 31, 7: 20, 8: 16, 9: 15}, counts)
+
+	for _, name := range []string{"Gate", "Shapechange", "Prismatic Wall", "Floating Disk", "Shocking Grasp"} {
+		_, ok := catalog.Spell(name)
+		assert.True(t, ok, "missing spell %s", name)
+	}
+}
+
+func TestCatalog_ListSubracesByRace(t *testing.T) {
+	catalog := loadCatalog(t)
+
+	entries, err := catalog.List(reference.KindSubraces, reference.Query{Race: "Dwarf"})
+
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "hill-dwarf", entries[0].(reference.Subrace).ID)
+}
+
+func TestCatalog_UnknownKind(t *testing.T) {
+	catalog := loadCatalog(t)
+
+	_, err := catalog.List(reference.Kind("monsters"), reference.Query{})
+	assert.ErrorIs(t, err, reference.ErrUnknownKind)
+
+	_, err = reference.ParseKind("monsters")
+	assert.ErrorIs(t, err, reference.ErrUnknownKind)
+}
+
+func TestCatalog_GetMissingEntry(t *testing.T) {
+	catalog := loadCatalog(t)
+
+	entry, err := catalog.Get(reference.KindSpells, "not-a-spell")
+	assert.NoError(t, err)
+	assert.Nil(t, entry)
+}
@@ -0,0 +1,81 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/dice"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
+)
+
+func TestPointBuyCost(t *testing.T) {
+	spent, err := rules.PointBuyCost([]int{15, 15, 15, 8, 8, 8})
+	require.NoError(t, err)
+	assert.Equal(t, 27, spent)
+
+	spent, err = rules.PointBuyCost([]int{15, 14, 13, 12, 10, 8})
+	require.NoError(t, err)
+	assert.Equal(t, 27, spent)
+
+	_, err = rules.PointBuyCost([]int{16, 8, 8, 8, 8, 8})
+	assert.EqualError(t, err, "score 16 is outside the point buy range 8-15")
+}
+
+func TestRollAbilityScores_Deterministic(t *testing.T) {
+	first := rules.RollAbilityScores(42)
+	second := rules.RollAbilityScores(42)
+
+	assert.Equal(t, first, second)
+	require.Len(t, first, 6)
+	for _, roll := range first {
+		require.Len(t, roll.Dice, 4)
+		sum := 0
+		for _, die := range roll.Dice {
+			assert.GreaterOrEqual(t, die, 1)
+			assert.LessOrEqual(t, die, 6)
+			assert.GreaterOrEqual(t, die, roll.Dropped)
+			sum += die
+		}
+		assert.Equal(t, sum-roll.Dropped, roll.Total)
+	}
+
+	assert.NotEqual(t, first, rules.RollAbilityScores(43))
+}
+
+func TestValidateAbilityScores(t *testing.T) {
+	var rolled []int
+	for _, roll := range rules.RollAbilityScores(7) {
+		rolled = append(rolled, roll.Total)
+	}
+	swapped := append([]int{rolled[5]}, rolled[:5]...)
+
+	tests := []struct {
+		name   string
+		method string
+		scores []int
+		seed   string
+		valid  bool
+	}{
+		{"point buy within budget", rules.MethodPointBuy, []int{15, 14, 13, 10, 10, 8}, "", true},
+		{"point buy over budget", rules.MethodPointBuy, []int{15, 15, 15, 15, 8, 8}, "", false},
+		{"point buy above 15", rules.MethodPointBuy, []int{17, 8, 8, 8, 8, 8}, "", false},
+		{"standard array in any order", rules.MethodStandardArray, []int{8, 10, 12, 13, 14, 15}, "", true},
+		{"standard array with a duplicate", rules.MethodStandardArray, []int{15, 15, 13, 12, 10, 8}, "", false},
+		{"rolled matches seed", rules.MethodRolled, swapped, dice.FormatSeed(7), true},
+		{"rolled from another seed", rules.MethodRolled, []int{18, 18, 18, 18, 18, 18}, dice.FormatSeed(7), false},
+		{"rolled without a seed", rules.MethodRolled, rolled, "", false},
+		{"unknown method", "dreamed", rolled, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errors := rules.ValidateAbilityScores(tt.method, tt.scores, tt.seed)
+			if tt.valid {
+				assert.Empty(t, errors)
+			} else {
+				assert.NotEmpty(t, errors)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+)
+
+func TestEngine_Apply_ArmorClass(t *testing.T) {
+	engine := newEngine(t)
+
+	shield := models.ArmorItem{Name: "Shield", Type: "Shield", ArmorClass: 2, Equipped: true}
+
+	tests := []struct {
+		name     string
+		class    string
+		dex      int
+		con      int
+		wis      int
+		armor    []models.ArmorItem
+		expected int
+	}{
+		{
+			name:     "unarmored",
+			class:    "Wizard",
+			dex:      14,
+			con:      10,
+			wis:      10,
+			expected: 12,
+		},
+		{
+			name:     "light armor adds full dexterity",
+			class:    "Rogue",
+			dex:      18,
+			con:      10,
+			wis:      10,
+			armor:    []models.ArmorItem{{Name: "Leather Armor", Type: "Light Armor", ArmorClass: 11, Equipped: true}},
+			expected: 15,
+		},
+		{
+			name:     "medium armor caps dexterity at 2",
+			class:    "Ranger",
+			dex:      18,
+			con:      10,
+			wis:      10,
+			armor:    []models.ArmorItem{{Name: "Half Plate", Type: "Medium Armor", ArmorClass: 15, Equipped: true}},
+			expected: 17,
+		},
+		{
+			name:     "heavy armor ignores dexterity",
+			class:    "Fighter",
+			dex:      18,
+			con:      10,
+			wis:      10,
+			armor:    []models.ArmorItem{{Name: "Chain Mail", Type: "Heavy Armor", ArmorClass: 16, Equipped: true}, shield},
+			expected: 18,
+		},
+		{
+			name:     "unequipped armor is ignored",
+			class:    "Fighter",
+			dex:      12,
+			con:      10,
+			wis:      10,
+			armor:    []models.ArmorItem{{Name: "Plate Armor", Type: "Heavy Armor", ArmorClass: 18}},
+			expected: 11,
+		},
+		{
+			name:     "catalog fills in missing armor details",
+			class:    "Fighter",
+			dex:      14,
+			con:      10,
+			wis:      10,
+			armor:    []models.ArmorItem{{Name: "Breastplate", Equipped: true}},
+			expected: 16,
+		},
+		{
+			name:     "barbarian unarmored defense with shield",
+			class:    "Barbarian",
+			dex:      14,
+			con:      16,
+			wis:      10,
+			armor:    []models.ArmorItem{shield},
+			expected: 17,
+		},
+		{
+			name:     "monk unarmored defense",
+			class:    "Monk",
+			dex:      16,
+			con:      10,
+			wis:      16,
+			expected: 16,
+		},
+		{
+			name:     "monk loses unarmored defense with shield",
+			class:    "Monk",
+			dex:      16,
+			con:      10,
+			wis:      16,
+			armor:    []models.ArmorItem{shield},
+			expected: 15,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := testCharacter(tt.class, 1)
+			character.AbilityScores.Dexterity.Score = tt.dex
+			character.AbilityScores.Constitution.Score = tt.con
+			character.AbilityScores.Wisdom.Score = tt.wis
+			character.Inventory = &models.Inventory{Armor: tt.armor}
+
+			engine.Apply(character)
+			assert.Equal(t, tt.expected, character.ArmorClass)
+		})
+	}
+}
+
+func TestEngine_Apply_ArmorClassEffects(t *testing.T) {
+	engine := newEngine(t)
+
+	mageArmor := testCharacter("Sorcerer", 1)
+	mageArmor.AbilityScores.Dexterity.Score = 16
+	mageArmor.ArmorClassEffects = &models.ArmorClassEffects{MageArmor: true}
+	engine.Apply(mageArmor)
+	assert.Equal(t, 16, mageArmor.ArmorClass)
+
+	natural := testCharacter("Druid", 1)
+	natural.AbilityScores.Dexterity.Score = 12
+	natural.ArmorClassEffects = &models.ArmorClassEffects{NaturalArmor: 13}
+	engine.Apply(natural)
+	assert.Equal(t, 14, natural.ArmorClass)
+}
+
+func TestEngine_Apply_NaturalArmorFromCatalog(t *testing.T) {
+	engine := newEngine(t)
+
+	sorcerer := testCharacter("Sorcerer", 1)
+	sorcerer.Subclass = "Draconic Bloodline"
+	sorcerer.AbilityScores.Dexterity.Score = 14
+	engine.Apply(sorcerer)
+	assert.Equal(t, 15, sorcerer.ArmorClass)
+	assert.Equal(t, []models.ArmorClassComponent{
+		{Source: "Natural Armor", Value: 13},
+		{Source: "Dexterity", Value: 2},
+	}, sorcerer.ArmorClassBreakdown)
+
+	plain := testCharacter("Sorcerer", 1)
+	plain.AbilityScores.Dexterity.Score = 14
+	engine.Apply(plain)
+	assert.Equal(t, 12, plain.ArmorClass)
+}
+
+func TestEngine_Apply_ArmorClassBreakdown(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testCharacter("Fighter", 1)
+	character.AbilityScores.Dexterity.Score = 16
+	character.Inventory = &models.Inventory{Armor: []models.ArmorItem{
+		{Name: "Scale Mail", Type: "Medium Armor", ArmorClass: 14, Equipped: true},
+		{Name: "Shield", Type: "Shield", ArmorClass: 2, Equipped: true},
+	}}
+
+	engine.Apply(character)
+
+	assert.Equal(t, 18, character.ArmorClass)
+	assert.Equal(t, []models.ArmorClassComponent{
+		{Source: "Scale Mail", Value: 14},
+		{Source: "Dexterity (max 2)", Value: 2},
+		{Source: "Shield", Value: 2},
+	}, character.ArmorClassBreakdown)
+}
@@ -0,0 +1,128 @@
+package rules_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
+)
+
+// fighterAttacks is a longsword attack and a shove, which deals no damage
+var fighterAttacks = []models.Attack{
+	{Name: "Longsword", AttackBonus: 5, Damage: "1d8+3", DamageType: "slashing"},
+	{Name: "Shove", AttackBonus: 3},
+}
+
+func TestEngine_RollAttack(t *testing.T) {
+	tests := []struct {
+		name     string
+		rolls    []int
+		request  models.AttackRollRequest
+		natural  int
+		hit      bool
+		critical bool
+		damage   int
+	}{
+		{"hit", []int{11, 6}, models.AttackRollRequest{TargetAC: 16}, 11, true, false, 9},
+		{"miss", []int{10}, models.AttackRollRequest{TargetAC: 16}, 10, false, false, 0},
+		{"natural 1 misses", []int{1}, models.AttackRollRequest{TargetAC: 5}, 1, false, false, 0},
+		{"natural 20 doubles dice", []int{20, 4, 5}, models.AttackRollRequest{TargetAC: 30}, 20, true, true, 12},
+		{"advantage keeps the highest", []int{3, 18, 8}, models.AttackRollRequest{TargetAC: 16, Mode: "advantage"}, 18, true, false, 11},
+		{"disadvantage keeps the lowest", []int{3, 18}, models.AttackRollRequest{TargetAC: 16, Mode: "disadvantage"}, 3, false, false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine, character := newEngine(t), testFighter(t, 3)
+			character.Attacks = slices.Clone(fighterAttacks)
+
+			result, err := engine.RollAttack(character, 0, &tt.request, rollsOf(tt.rolls...))
+			require.NoError(t, err)
+
+			assert.Equal(t, "Longsword", result.Attack)
+			assert.Equal(t, tt.natural, result.Natural)
+			assert.Equal(t, tt.natural+5, result.Total)
+			assert.Equal(t, tt.hit, result.Hit)
+			assert.Equal(t, tt.critical, result.Critical)
+			if tt.hit {
+				require.NotNil(t, result.Damage)
+				assert.Equal(t, tt.damage, result.Damage.Total)
+				assert.Equal(t, "slashing", result.DamageType)
+			} else {
+				assert.Nil(t, result.Damage)
+			}
+		})
+	}
+}
+
+func TestEngine_RollAttack_CriticalNotation(t *testing.T) {
+	engine, character := newEngine(t), testFighter(t, 3)
+	character.Attacks = slices.Clone(fighterAttacks)
+
+	result, err := engine.RollAttack(character, 0, &models.AttackRollRequest{TargetAC: 10}, rollsOf(20, 1, 1))
+	require.NoError(t, err)
+	assert.Equal(t, "1d20+5", result.AttackRoll.Notation)
+	assert.Equal(t, "2d8+3", result.Damage.Notation)
+}
+
+func TestEngine_RollAttack_ConditionsCancelAdvantage(t *testing.T) {
+	engine, character := newEngine(t), testFighter(t, 3)
+	character.Attacks = slices.Clone(fighterAttacks)
+	require.NoError(t, engine.AddCondition(character, &models.ConditionRequest{Name: "poisoned"}))
+
+	result, err := engine.RollAttack(character, 0, &models.AttackRollRequest{TargetAC: 10, Mode: "advantage"}, rollsOf(12, 3))
+	require.NoError(t, err)
+	assert.Empty(t, result.Mode)
+	assert.Len(t, result.AttackRoll.Terms[0].Dice, 1)
+
+	result, err = engine.RollAttack(character, 0, &models.AttackRollRequest{TargetAC: 10}, rollsOf(12, 3))
+	require.NoError(t, err)
+	assert.Equal(t, rules.RollDisadvantage, result.Mode)
+}
+
+func TestEngine_RollAttack_NoDamage(t *testing.T) {
+	engine, character := newEngine(t), testFighter(t, 3)
+	character.Attacks = slices.Clone(fighterAttacks)
+
+	result, err := engine.RollAttack(character, 1, &models.AttackRollRequest{TargetAC: 10}, rollsOf(15))
+	require.NoError(t, err)
+	assert.True(t, result.Hit)
+	assert.Nil(t, result.Damage)
+}
+
+func TestEngine_RollAttack_Errors(t *testing.T) {
+	engine, character := newEngine(t), testFighter(t, 3)
+	character.Attacks = slices.Clone(fighterAttacks)
+
+	_, err := engine.RollAttack(character, 2, &models.AttackRollRequest{TargetAC: 10}, rollsOf())
+	assert.EqualError(t, err, "character has no attack at index 2")
+
+	character.Attacks[0].Damage = "a lot"
+	_, err = engine.RollAttack(character, 0, &models.AttackRollRequest{TargetAC: 10}, rollsOf(15))
+	assert.ErrorContains(t, err, `attack "Longsword" has damage "a lot" that cannot be rolled`)
+
+	require.NoError(t, engine.AddCondition(character, &models.ConditionRequest{Name: "stunned"}))
+	_, err = engine.RollAttack(character, 0, &models.AttackRollRequest{TargetAC: 10}, rollsOf())
+	assert.EqualError(t, err, "character is incapacitated and cannot attack")
+
+	character.HitPoints.Current = 0
+	_, err = engine.RollAttack(character, 0, &models.AttackRollRequest{TargetAC: 10}, rollsOf())
+	assert.EqualError(t, err, "character is dying and cannot attack")
+}
+
+func TestEngine_RollAttack_RecalculatesWeaponAttacks(t *testing.T) {
+	engine := newEngine(t)
+	character := testFighter(t, 3, models.InventoryItem{ID: "a", ItemID: "longsword", Quantity: 1, Equipped: true})
+	character.AbilityScores.Strength.Score = 16
+	character.Attacks = []models.Attack{{Name: "Longsword", AttackBonus: 0, Damage: "1d4", ItemID: "a"}}
+
+	result, err := engine.RollAttack(character, 0, &models.AttackRollRequest{TargetAC: 16}, rollsOf(11, 6))
+	require.NoError(t, err)
+	assert.Equal(t, 16, result.Total)
+	assert.True(t, result.Hit)
+	require.NotNil(t, result.Damage)
+	assert.Equal(t, 9, result.Damage.Total)
+}
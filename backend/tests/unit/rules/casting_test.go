@@ -0,0 +1,146 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
+)
+
+func TestEngine_Cast_SpendsSlots(t *testing.T) {
+	engine := newEngine(t)
+	character := testWizard(t)
+
+	result, err := engine.Cast(character, &models.CastRequest{Spell: "magic missile"})
+	require.NoError(t, err)
+	assert.Equal(t, "Magic Missile", result.Spell)
+	assert.Equal(t, rules.SlotSpell, result.Slot)
+	assert.Equal(t, 1, result.SlotLevel)
+	assert.Equal(t, 3, result.SlotsRemaining)
+	assert.False(t, result.Upcast)
+
+	result, err = engine.Cast(character, &models.CastRequest{Spell: "Magic Missile", SlotLevel: 2})
+	require.NoError(t, err)
+	assert.True(t, result.Upcast)
+	assert.Equal(t, 1, character.Spellcasting.SpellSlots.Level2.Used)
+
+	_, err = engine.Cast(character, &models.CastRequest{Spell: "Blur"})
+	require.NoError(t, err)
+	_, err = engine.Cast(character, &models.CastRequest{Spell: "Blur"})
+	assert.EqualError(t, err, "no level 2 spell slots remaining")
+
+	_, err = engine.Cast(character, &models.CastRequest{Spell: "Hold Person", SlotLevel: 1})
+	assert.EqualError(t, err, "Hold Person is a level 2 spell and cannot be cast with a level 1 slot")
+
+	result, err = engine.Cast(character, &models.CastRequest{Spell: "Fire Bolt"})
+	require.NoError(t, err)
+	assert.Empty(t, result.Slot)
+	assert.Equal(t, 1, character.Spellcasting.SpellSlots.Level1.Used)
+}
+
+func TestEngine_Cast_PreparedOrKnown(t *testing.T) {
+	engine := newEngine(t)
+
+	// A wizard's unprepared spellbook spells can't be cast normally
+	_, err := engine.Cast(testWizard(t), &models.CastRequest{Spell: "Find Familiar"})
+	assert.EqualError(t, err, "Find Familiar is not prepared or known")
+
+	_, err = engine.Cast(testWizard(t), &models.CastRequest{Spell: "Fireball", SlotLevel: 3})
+	assert.EqualError(t, err, "Fireball is not prepared or known")
+
+	_, err = engine.Cast(testWizard(t), &models.CastRequest{Spell: "Light"})
+	assert.EqualError(t, err, "Light is not a known cantrip")
+
+	// Sorcerers know their spells and don't prepare them
+	sorcerer := testCharacter("Sorcerer", 1)
+	sorcerer.HitPoints.Current = 5
+	sorcerer.Spellcasting = &models.Spellcasting{SpellsKnown: []string{"Detect Magic"}}
+	result, err := engine.Cast(sorcerer, &models.CastRequest{Spell: "Detect Magic"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.SlotLevel)
+}
+
+func TestEngine_Cast_Rituals(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testWizard(t)
+	result, err := engine.Cast(character, &models.CastRequest{Spell: "Find Familiar", Ritual: true})
+	require.NoError(t, err)
+	assert.True(t, result.Ritual)
+	assert.Empty(t, result.Slot)
+	assert.Equal(t, 0, character.Spellcasting.SpellSlots.Level1.Used)
+
+	_, err = engine.Cast(character, &models.CastRequest{Spell: "Magic Missile", Ritual: true})
+	assert.EqualError(t, err, "Magic Missile is not a ritual")
+
+	sorcerer := testCharacter("Sorcerer", 1)
+	sorcerer.HitPoints.Current = 5
+	sorcerer.Spellcasting = &models.Spellcasting{SpellsKnown: []string{"Detect Magic"}}
+	_, err = engine.Cast(sorcerer, &models.CastRequest{Spell: "Detect Magic", Ritual: true})
+	assert.EqualError(t, err, "none of the character's classes can cast Detect Magic as a ritual")
+
+	// Clerics cast prepared rituals
+	cleric := testCharacter("Cleric", 1)
+	cleric.HitPoints.Current = 5
+	cleric.Spellcasting = &models.Spellcasting{PreparedSpells: []string{"Detect Magic"}}
+	result, err = engine.Cast(cleric, &models.CastRequest{Spell: "Detect Magic", Ritual: true})
+	require.NoError(t, err)
+	assert.True(t, result.Concentration)
+	assert.Equal(t, &models.Concentration{Spell: "Detect Magic", SlotLevel: 1}, cleric.Spellcasting.Concentration)
+}
+
+func TestEngine_Cast_Concentration(t *testing.T) {
+	engine := newEngine(t)
+	character := testWizard(t)
+
+	_, err := engine.Cast(character, &models.CastRequest{Spell: "Hold Person", SlotLevel: 3})
+	assert.EqualError(t, err, "no level 3 spell slots remaining")
+
+	result, err := engine.Cast(character, &models.CastRequest{Spell: "Hold Person"})
+	require.NoError(t, err)
+	assert.True(t, result.Concentration)
+	assert.Empty(t, result.EndedConcentration)
+
+	result, err = engine.Cast(character, &models.CastRequest{Spell: "Blur"})
+	require.NoError(t, err)
+	assert.Equal(t, "Hold Person", result.EndedConcentration)
+	assert.Equal(t, &models.Concentration{Spell: "Blur", SlotLevel: 2}, character.Spellcasting.Concentration)
+
+	// Shield doesn't need concentration and leaves Blur running
+	_, err = engine.Cast(character, &models.CastRequest{Spell: "Shield"})
+	require.NoError(t, err)
+	assert.Equal(t, "Blur", character.Spellcasting.Concentration.Spell)
+
+	// Falling unconscious ends it
+	_, err = engine.ChangeHitPoints(character, &models.HitPointChangeRequest{Type: rules.ChangeDamage, Amount: 12})
+	require.NoError(t, err)
+	assert.Nil(t, character.Spellcasting.Concentration)
+
+	_, err = engine.Cast(character, &models.CastRequest{Spell: "Fire Bolt"})
+	assert.EqualError(t, err, "character is dying and cannot cast spells")
+}
+
+func TestEngine_Cast_PactMagic(t *testing.T) {
+	engine := newEngine(t)
+
+	warlock := testCharacter("Warlock", 3)
+	warlock.HitPoints.Current = 10
+	warlock.Spellcasting = &models.Spellcasting{SpellsKnown: []string{"Hellish Rebuke", "Darkness"}}
+
+	result, err := engine.Cast(warlock, &models.CastRequest{Spell: "Hellish Rebuke"})
+	require.NoError(t, err)
+	assert.Equal(t, rules.SlotPactMagic, result.Slot)
+	assert.Equal(t, 2, result.SlotLevel)
+	assert.True(t, result.Upcast)
+	assert.Equal(t, 1, result.SlotsRemaining)
+
+	_, err = engine.Cast(warlock, &models.CastRequest{Spell: "Darkness", SlotLevel: 1})
+	assert.EqualError(t, err, "the character's Pact Magic slots are level 2")
+
+	_, err = engine.Cast(warlock, &models.CastRequest{Spell: "Darkness"})
+	require.NoError(t, err)
+	_, err = engine.Cast(warlock, &models.CastRequest{Spell: "Darkness"})
+	assert.EqualError(t, err, "no Pact Magic slots remaining")
+}
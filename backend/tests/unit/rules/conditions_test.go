@@ -0,0 +1,126 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
+)
+
+func TestEngine_AddCondition_Poisoned(t *testing.T) {
+	engine, character := newEngine(t), testFighter(t, 5)
+	assert.Nil(t, character.ConditionEffects)
+
+	err := engine.AddCondition(character, &models.ConditionRequest{Name: "Poisoned", Source: "Giant Spider", Duration: "1 hour"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []models.Condition{{Name: "poisoned", Source: "Giant Spider", Duration: "1 hour"}}, character.Conditions)
+	require.NotNil(t, character.ConditionEffects)
+	assert.Equal(t, rules.RollDisadvantage, character.ConditionEffects.AttackRolls)
+	assert.Equal(t, rules.RollDisadvantage, character.ConditionEffects.AbilityChecks)
+	assert.Empty(t, character.ConditionEffects.SavingThrows)
+
+	// Adding it again replaces the entry
+	err = engine.AddCondition(character, &models.ConditionRequest{Name: "poisoned", Duration: "1 minute"})
+	require.NoError(t, err)
+	assert.Equal(t, []models.Condition{{Name: "poisoned", Duration: "1 minute"}}, character.Conditions)
+
+	require.NoError(t, engine.RemoveCondition(character, "Poisoned"))
+	assert.Empty(t, character.Conditions)
+	assert.Nil(t, character.ConditionEffects)
+
+	assert.EqualError(t, engine.RemoveCondition(character, "poisoned"), "character is not poisoned")
+	assert.EqualError(t, engine.AddCondition(character, &models.ConditionRequest{Name: "sleepy"}), `unknown condition "sleepy"`)
+}
+
+func TestEngine_AddCondition_AdvantageCancelsDisadvantage(t *testing.T) {
+	engine, character := newEngine(t), testFighter(t, 5)
+
+	require.NoError(t, engine.AddCondition(character, &models.ConditionRequest{Name: "invisible"}))
+	assert.Equal(t, rules.RollAdvantage, character.ConditionEffects.AttackRolls)
+
+	require.NoError(t, engine.AddCondition(character, &models.ConditionRequest{Name: "prone"}))
+	assert.Empty(t, character.ConditionEffects.AttackRolls)
+}
+
+func TestEngine_AddCondition_SpeedZero(t *testing.T) {
+	engine, character := newEngine(t), testFighter(t, 5)
+
+	require.NoError(t, engine.AddCondition(character, &models.ConditionRequest{Name: "paralyzed"}))
+	assert.Equal(t, 0, character.EffectiveSpeed.Walk)
+	assert.True(t, character.ConditionEffects.Incapacitated)
+	assert.Equal(t, []string{"strength", "dexterity"}, character.ConditionEffects.AutoFailSaves)
+}
+
+func TestEngine_Exhaustion(t *testing.T) {
+	engine, character := newEngine(t), testFighter(t, 5)
+	maximum := character.HitPoints.Maximum
+	walk := character.EffectiveSpeed.Walk
+
+	require.NoError(t, engine.AddCondition(character, &models.ConditionRequest{Name: "exhaustion"}))
+	assert.Equal(t, 1, character.Exhaustion)
+	assert.Empty(t, character.Conditions)
+	assert.Equal(t, rules.RollDisadvantage, character.ConditionEffects.AbilityChecks)
+	assert.Equal(t, walk, character.EffectiveSpeed.Walk)
+
+	require.NoError(t, engine.AddCondition(character, &models.ConditionRequest{Name: "exhaustion"}))
+	assert.Equal(t, walk/2, character.EffectiveSpeed.Walk)
+
+	require.NoError(t, engine.AddCondition(character, &models.ConditionRequest{Name: "exhaustion", Levels: 2}))
+	assert.Equal(t, 4, character.Exhaustion)
+	assert.Equal(t, rules.RollDisadvantage, character.ConditionEffects.AttackRolls)
+	assert.Equal(t, rules.RollDisadvantage, character.ConditionEffects.SavingThrows)
+	assert.Equal(t, maximum/2, character.HitPoints.Maximum)
+	assert.Equal(t, maximum/2, character.HitPoints.Current)
+
+	require.NoError(t, engine.AddCondition(character, &models.ConditionRequest{Name: "exhaustion"}))
+	assert.Equal(t, 0, character.EffectiveSpeed.Walk)
+
+	require.NoError(t, engine.RemoveCondition(character, "exhaustion"))
+	assert.Equal(t, 4, character.Exhaustion)
+
+	// A long rest removes a level and restores the full maximum
+	_, err := engine.LongRest(character)
+	require.NoError(t, err)
+	assert.Equal(t, 3, character.Exhaustion)
+	assert.Equal(t, maximum, character.HitPoints.Current)
+
+	require.NoError(t, engine.AddCondition(character, &models.ConditionRequest{Name: "exhaustion", Levels: 5}))
+	assert.Equal(t, rules.MaxExhaustion, character.Exhaustion)
+	assert.Equal(t, rules.StatusDead, character.Status)
+}
+
+func TestEngine_Exhaustion_HomebrewClass(t *testing.T) {
+	engine := newEngine(t)
+	character := testCharacter("Gunslinger", 5)
+	character.HitPoints = models.HitPoints{Maximum: 41, Current: 41}
+	engine.Apply(character)
+
+	require.NoError(t, engine.AddCondition(character, &models.ConditionRequest{Name: "exhaustion", Levels: 4}))
+	assert.Equal(t, 20, character.HitPoints.Maximum)
+	assert.Equal(t, 21, character.HitPoints.Reduction)
+	assert.Equal(t, 20, character.HitPoints.Current)
+
+	// Recalculating doesn't halve the maximum again
+	engine.Apply(character)
+	assert.Equal(t, 20, character.HitPoints.Maximum)
+
+	_, err := engine.LongRest(character)
+	require.NoError(t, err)
+	assert.Equal(t, 41, character.HitPoints.Maximum)
+	assert.Zero(t, character.HitPoints.Reduction)
+	assert.Equal(t, 41, character.HitPoints.Current)
+}
+
+func TestEngine_Apply_DyingIsUnconscious(t *testing.T) {
+	engine, character := newEngine(t), testFighter(t, 5)
+	character.HitPoints.Current = 0
+	engine.Apply(character)
+
+	require.NotNil(t, character.ConditionEffects)
+	assert.True(t, character.ConditionEffects.Incapacitated)
+	assert.Equal(t, 0, character.EffectiveSpeed.Walk)
+	assert.Empty(t, character.Conditions)
+}
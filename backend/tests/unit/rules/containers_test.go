@@ -0,0 +1,207 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/reference"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
+)
+
+func TestEngine_Apply_ContainerWeight(t *testing.T) {
+	engine := newEngine(t)
+	bag := bagOfHolding("bag")
+	bag.ContainerID = "pack"
+	character := testFighter(t, 1,
+		models.InventoryItem{ID: "pack", ItemID: "backpack", Quantity: 1},
+		models.InventoryItem{ID: "rations", ItemID: "rations-1-day", Quantity: 5, ContainerID: "pack"},
+		bag,
+		models.InventoryItem{ID: "crowbar", ItemID: "crowbar", Quantity: 1, ContainerID: "bag"},
+		models.InventoryItem{ID: "hammer", ItemID: "hammer", Quantity: 1, ContainerID: "bag"},
+	)
+
+	engine.Apply(character)
+
+	// Backpack 5 + rations 10 + bag 15; the crowbar and hammer weigh nothing
+	// inside the bag
+	assert.Equal(t, 30.0, character.Encumbrance.CarriedWeight)
+	assert.Equal(t, 25.0, character.Inventory.Items[0].ContentsWeight)
+	assert.Equal(t, 8.0, character.Inventory.Items[2].ContentsWeight)
+	assert.Zero(t, character.Inventory.Items[1].ContentsWeight)
+}
+
+func TestContainerErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		items    []models.InventoryItem
+		expected string
+	}{
+		{
+			name:     "missing container",
+			items:    []models.InventoryItem{{ID: "a", ItemID: "torch", ContainerID: "z"}},
+			expected: `Torch is packed in "z", which the character doesn't have`,
+		},
+		{
+			name: "not a container",
+			items: []models.InventoryItem{
+				{ID: "a", ItemID: "torch", ContainerID: "b"},
+				{ID: "b", ItemID: "crowbar"},
+			},
+			expected: "Torch is packed in Crowbar, which is not a container",
+		},
+		{
+			name: "packing loop",
+			items: []models.InventoryItem{
+				{ID: "a", ItemID: "sack", ContainerID: "b"},
+				{ID: "b", ItemID: "sack", ContainerID: "a"},
+			},
+			expected: "Sack cannot be packed inside itself",
+		},
+		{
+			name: "equipped",
+			items: []models.InventoryItem{
+				{ID: "a", ItemID: "dagger", Equipped: true, ContainerID: "b"},
+				{ID: "b", ItemID: "backpack"},
+			},
+			expected: "Dagger is equipped and cannot be packed in Backpack",
+		},
+		{
+			name: "over capacity",
+			items: []models.InventoryItem{
+				{ID: "a", ItemID: "rations-1-day", Quantity: 16, ContainerID: "b"},
+				{ID: "b", ItemID: "backpack"},
+			},
+			expected: "Backpack holds 32 lb but can only hold 30 lb",
+		},
+	}
+
+	catalog, err := reference.Load()
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errors := rules.ContainerErrors(catalog, &models.Inventory{Items: tt.items})
+
+			assert.Contains(t, errors, tt.expected)
+		})
+	}
+}
+
+func TestContainerErrors_OnlyLoopMembersArePackedInsideThemselves(t *testing.T) {
+	catalog, err := reference.Load()
+	require.NoError(t, err)
+
+	errors := rules.ContainerErrors(catalog, &models.Inventory{Items: []models.InventoryItem{
+		{ID: "a", ItemID: "sack", ContainerID: "b"},
+		{ID: "b", ItemID: "chest", ContainerID: "a"},
+		{ID: "c", ItemID: "torch", ContainerID: "a"},
+	}})
+
+	assert.ElementsMatch(t, []string{"Sack cannot be packed inside itself", "Chest cannot be packed inside itself"}, errors)
+}
+
+func TestContainerErrors_WeightlessContentsDontFillOuterContainer(t *testing.T) {
+	catalog, err := reference.Load()
+	require.NoError(t, err)
+	bag := bagOfHolding("bag")
+	bag.ContainerID = "pack"
+
+	errors := rules.ContainerErrors(catalog, &models.Inventory{Items: []models.InventoryItem{
+		{ID: "pack", ItemID: "backpack"},
+		bag,
+		{ID: "rations", ItemID: "rations-1-day", Quantity: 100, ContainerID: "bag"},
+	}})
+
+	assert.Empty(t, errors)
+}
+
+func TestEngine_MoveItem(t *testing.T) {
+	engine := newEngine(t)
+	character := testFighter(t, 1,
+		models.InventoryItem{ID: "pack", ItemID: "backpack", Quantity: 1},
+		models.InventoryItem{ID: "torches", ItemID: "torch", Quantity: 10},
+	)
+
+	require.NoError(t, engine.MoveItem(character, "torches", &models.MoveItemRequest{ContainerID: "pack", Quantity: 4}))
+	require.Len(t, character.Inventory.Items, 3)
+	assert.Equal(t, 6, character.Inventory.Items[1].Quantity)
+	assert.Empty(t, character.Inventory.Items[1].ContainerID)
+	packed := character.Inventory.Items[2]
+	assert.Equal(t, 4, packed.Quantity)
+	assert.Equal(t, "pack", packed.ContainerID)
+	assert.Equal(t, 4.0, character.Inventory.Items[0].ContentsWeight)
+
+	require.NoError(t, engine.MoveItem(character, packed.ID, &models.MoveItemRequest{}))
+	assert.Empty(t, character.Inventory.Items[2].ContainerID)
+	assert.Zero(t, character.Inventory.Items[0].ContentsWeight)
+}
+
+func TestEngine_MoveItem_SplitKeepsOwnCharges(t *testing.T) {
+	engine := newEngine(t)
+	charges := 3
+	character := testFighter(t, 1,
+		models.InventoryItem{ID: "pack", ItemID: "backpack", Quantity: 1},
+		models.InventoryItem{ID: "torches", ItemID: "torch", Quantity: 2, Charges: &charges},
+	)
+
+	require.NoError(t, engine.MoveItem(character, "torches", &models.MoveItemRequest{ContainerID: "pack", Quantity: 1}))
+	require.Len(t, character.Inventory.Items, 3)
+
+	*character.Inventory.Items[2].Charges = 0
+	assert.Equal(t, 3, *character.Inventory.Items[1].Charges)
+}
+
+func TestEngine_MoveItem_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		request  models.MoveItemRequest
+		expected string
+	}{
+		{"unknown item", "z", models.MoveItemRequest{ContainerID: "pack"}, `character has no item "z"`},
+		{"unknown container", "rations", models.MoveItemRequest{ContainerID: "z"}, `character has no item "z"`},
+		{"not a container", "rations", models.MoveItemRequest{ContainerID: "crowbar"}, "Crowbar is not a container"},
+		{"not packed", "rations", models.MoveItemRequest{}, "Rations (1 day) is not in a container"},
+		{"into itself", "pack", models.MoveItemRequest{ContainerID: "pack"}, "cannot move Backpack: Backpack cannot be packed inside itself"},
+		{"over capacity", "rations", models.MoveItemRequest{ContainerID: "pack"}, "cannot move Rations (1 day): Backpack holds 40 lb but can only hold 30 lb"},
+		{"too many", "rations", models.MoveItemRequest{ContainerID: "pack", Quantity: 21}, "only 20 of Rations (1 day) to move"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := newEngine(t)
+			character := testFighter(t, 1,
+				models.InventoryItem{ID: "pack", ItemID: "backpack", Quantity: 1},
+				models.InventoryItem{ID: "rations", ItemID: "rations-1-day", Quantity: 20},
+				models.InventoryItem{ID: "crowbar", ItemID: "crowbar", Quantity: 1},
+			)
+
+			err := engine.MoveItem(character, tt.id, &tt.request)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.expected, err.Error())
+			require.Len(t, character.Inventory.Items, 3)
+			for _, item := range character.Inventory.Items {
+				assert.Empty(t, item.ContainerID, "a rejected move leaves the inventory as it was")
+			}
+		})
+	}
+}
+
+func TestEngine_MoveItem_UnequipsAndEquipUnpacks(t *testing.T) {
+	engine := newEngine(t)
+	character := testFighter(t, 1,
+		models.InventoryItem{ID: "pack", ItemID: "backpack", Quantity: 1},
+		models.InventoryItem{ID: "dagger", ItemID: "dagger", Quantity: 1, Equipped: true},
+	)
+
+	require.NoError(t, engine.MoveItem(character, "dagger", &models.MoveItemRequest{ContainerID: "pack"}))
+	assert.False(t, character.Inventory.Items[1].Equipped)
+	assert.Empty(t, character.Attacks)
+
+	require.NoError(t, engine.Equip(character, "dagger", &models.EquipRequest{}))
+	assert.True(t, character.Inventory.Items[1].Equipped)
+	assert.Empty(t, character.Inventory.Items[1].ContainerID)
+}
@@ -0,0 +1,118 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
+)
+
+func TestEngine_Transact(t *testing.T) {
+	tests := []struct {
+		name        string
+		purse       models.Currency
+		transaction models.CurrencyTransaction
+		paid        *models.Currency
+		received    *models.Currency
+		balance     models.Currency
+	}{
+		{
+			name:        "credit",
+			purse:       models.Currency{Gold: 1},
+			transaction: models.CurrencyTransaction{Type: rules.TransactionCredit, Amount: 10, Denomination: rules.Gold},
+			received:    &models.Currency{Gold: 10},
+			balance:     models.Currency{Gold: 11},
+		},
+		{
+			name:        "exact coins",
+			purse:       models.Currency{Gold: 5, Platinum: 1},
+			transaction: models.CurrencyTransaction{Type: rules.TransactionDebit, Amount: 2, Denomination: rules.Gold},
+			paid:        &models.Currency{Gold: 2},
+			balance:     models.Currency{Gold: 3, Platinum: 1},
+		},
+		{
+			name:        "change from platinum",
+			purse:       models.Currency{Platinum: 2},
+			transaction: models.CurrencyTransaction{Type: rules.TransactionDebit, Amount: 3, Denomination: rules.Gold},
+			paid:        &models.Currency{Platinum: 1},
+			received:    &models.Currency{Gold: 7},
+			balance:     models.Currency{Gold: 7, Platinum: 1},
+		},
+		{
+			name:        "smaller coins before breaking a larger one",
+			purse:       models.Currency{Silver: 3, Gold: 1},
+			transaction: models.CurrencyTransaction{Type: rules.TransactionDebit, Amount: 5, Denomination: rules.Silver},
+			paid:        &models.Currency{Silver: 3, Gold: 1},
+			received:    &models.Currency{Silver: 8},
+			balance:     models.Currency{Silver: 8},
+		},
+		{
+			name:        "several larger coins",
+			purse:       models.Currency{Gold: 2},
+			transaction: models.CurrencyTransaction{Type: rules.TransactionDebit, Amount: 150, Denomination: rules.Copper},
+			paid:        &models.Currency{Gold: 2},
+			received:    &models.Currency{Silver: 5},
+			balance:     models.Currency{Silver: 5},
+		},
+		{
+			name:        "change in gold, silver and copper",
+			purse:       models.Currency{Copper: 2, Platinum: 1},
+			transaction: models.CurrencyTransaction{Type: rules.TransactionDebit, Amount: 15, Denomination: rules.Copper},
+			paid:        &models.Currency{Copper: 2, Platinum: 1},
+			received:    &models.Currency{Copper: 7, Silver: 8, Gold: 9},
+			balance:     models.Currency{Copper: 7, Silver: 8, Gold: 9},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := newEngine(t)
+			character := testCharacter("Fighter", 1)
+			purse := tt.purse
+			character.Inventory = &models.Inventory{Currency: &purse}
+
+			result, err := engine.Transact(character, tt.transaction)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.paid, result.Transaction.Paid)
+			assert.Equal(t, tt.received, result.Transaction.Received)
+			assert.Equal(t, tt.balance, result.Transaction.Balance)
+			assert.Equal(t, tt.balance, *character.Inventory.Currency)
+			assert.Equal(t, []models.CurrencyTransaction{result.Transaction}, character.CurrencyLedger)
+		})
+	}
+}
+
+func TestEngine_Transact_RunningBalance(t *testing.T) {
+	engine := newEngine(t)
+	character := testCharacter("Fighter", 1)
+
+	_, err := engine.Transact(character, models.CurrencyTransaction{Type: rules.TransactionCredit, Amount: 50, Denomination: rules.Gold, Memo: "Dragon hoard"})
+	require.NoError(t, err)
+	_, err = engine.Transact(character, models.CurrencyTransaction{Type: rules.TransactionDebit, Amount: 15, Denomination: rules.Gold, Memo: "Chain mail deposit"})
+	require.NoError(t, err)
+
+	require.Len(t, character.CurrencyLedger, 2)
+	assert.Equal(t, models.Currency{Gold: 50}, character.CurrencyLedger[0].Balance)
+	assert.Equal(t, models.Currency{Gold: 35}, character.CurrencyLedger[1].Balance)
+	assert.Equal(t, "Chain mail deposit", character.CurrencyLedger[1].Memo)
+}
+
+func TestEngine_Transact_Overdraft(t *testing.T) {
+	engine := newEngine(t)
+	character := testCharacter("Fighter", 1)
+	character.Inventory = &models.Inventory{Currency: &models.Currency{Gold: 9, Silver: 9}}
+
+	_, err := engine.Transact(character, models.CurrencyTransaction{Type: rules.TransactionDebit, Amount: 1, Denomination: rules.Platinum})
+
+	assert.EqualError(t, err, "insufficient funds: 1 pp is worth 1000 cp but the purse holds 990 cp")
+	assert.Equal(t, models.Currency{Gold: 9, Silver: 9}, *character.Inventory.Currency)
+	assert.Empty(t, character.CurrencyLedger)
+}
+
+func TestCurrencyValue(t *testing.T) {
+	assert.Equal(t, 0, rules.CurrencyValue(nil))
+	assert.Equal(t, 1561, rules.CurrencyValue(&models.Currency{Copper: 1, Silver: 1, Electrum: 1, Gold: 5, Platinum: 1}))
+}
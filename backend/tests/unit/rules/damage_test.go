@@ -0,0 +1,161 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
+)
+
+func TestEngine_ChangeHitPoints_Damage(t *testing.T) {
+	engine := newEngine(t)
+
+	character := withHitPoints(t, testFighter(t, 3), 20, 5)
+	result, err := engine.ChangeHitPoints(character, &models.HitPointChangeRequest{Type: rules.ChangeDamage, Amount: 8, DamageType: "slashing"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, result.TemporaryAbsorbed)
+	assert.Equal(t, -3, result.Change)
+	assert.Equal(t, 0, character.HitPoints.Temporary)
+	assert.Equal(t, 17, character.HitPoints.Current)
+	assert.False(t, result.Dying)
+}
+
+func TestEngine_ChangeHitPoints_DamageAdjustments(t *testing.T) {
+	engine := newEngine(t)
+
+	tests := []struct {
+		name       string
+		setup      func(character *models.Character)
+		damageType string
+		adjusted   int
+		adjustment string
+	}{
+		{
+			name:       "untyped damage is not adjusted",
+			adjusted:   9,
+			damageType: "",
+		},
+		{
+			name:       "resistance halves rounding down",
+			setup:      func(c *models.Character) { c.DamageResistances = []string{"Fire"} },
+			damageType: "fire",
+			adjusted:   4,
+			adjustment: rules.AdjustmentResistance,
+		},
+		{
+			name:       "racial resistance",
+			setup:      func(c *models.Character) { c.Race = "Dwarf" },
+			damageType: "poison",
+			adjusted:   4,
+			adjustment: rules.AdjustmentResistance,
+		},
+		{
+			name:       "immunity",
+			setup:      func(c *models.Character) { c.DamageImmunities = []string{"poison"} },
+			damageType: "poison",
+			adjusted:   0,
+			adjustment: rules.AdjustmentImmunity,
+		},
+		{
+			name:       "vulnerability doubles",
+			setup:      func(c *models.Character) { c.DamageVulnerabilities = []string{"radiant"} },
+			damageType: "radiant",
+			adjusted:   18,
+			adjustment: rules.AdjustmentVulnerability,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := withHitPoints(t, testFighter(t, 3), 25, 0)
+			if tt.setup != nil {
+				tt.setup(character)
+			}
+
+			result, err := engine.ChangeHitPoints(character, &models.HitPointChangeRequest{Type: rules.ChangeDamage, Amount: 9, DamageType: tt.damageType})
+			require.NoError(t, err)
+			assert.Equal(t, tt.adjusted, result.Adjusted)
+			assert.Equal(t, tt.adjustment, result.Adjustment)
+			assert.Equal(t, 25-tt.adjusted, character.HitPoints.Current)
+		})
+	}
+
+	_, err := engine.ChangeHitPoints(withHitPoints(t, testFighter(t, 3), 25, 0), &models.HitPointChangeRequest{Type: rules.ChangeDamage, Amount: 9, DamageType: "sonic"})
+	assert.EqualError(t, err, `unknown damage type "sonic"`)
+}
+
+func TestEngine_ChangeHitPoints_DroppingToZero(t *testing.T) {
+	engine := newEngine(t)
+
+	character := withHitPoints(t, testFighter(t, 3), 10, 0)
+	character.DeathSaves = &models.DeathSaves{Successes: 2}
+	result, err := engine.ChangeHitPoints(character, &models.HitPointChangeRequest{Type: rules.ChangeDamage, Amount: 30})
+	require.NoError(t, err)
+	assert.True(t, result.Dying)
+	assert.False(t, result.Dead)
+	assert.Equal(t, -10, result.Change)
+	assert.Equal(t, models.DeathSaves{}, *character.DeathSaves)
+
+	// Damage at 0 hit points is a failed save, a critical hit two
+	result, err = engine.ChangeHitPoints(character, &models.HitPointChangeRequest{Type: rules.ChangeDamage, Amount: 3, Critical: true})
+	require.NoError(t, err)
+	assert.Equal(t, 2, character.DeathSaves.Failures)
+	assert.True(t, result.Dying)
+
+	result, err = engine.ChangeHitPoints(character, &models.HitPointChangeRequest{Type: rules.ChangeDamage, Amount: 3})
+	require.NoError(t, err)
+	assert.True(t, result.Dead)
+	assert.False(t, result.InstantDeath)
+
+	_, err = engine.ChangeHitPoints(character, &models.HitPointChangeRequest{Type: rules.ChangeHealing, Amount: 3})
+	assert.EqualError(t, err, "character is dead")
+}
+
+func TestEngine_ChangeHitPoints_InstantDeath(t *testing.T) {
+	engine := newEngine(t)
+
+	// 10 hit points absorb the first 10; the remaining 25 equal the maximum
+	character := withHitPoints(t, testFighter(t, 3), 10, 0)
+	result, err := engine.ChangeHitPoints(character, &models.HitPointChangeRequest{Type: rules.ChangeDamage, Amount: 35})
+	require.NoError(t, err)
+	assert.True(t, result.Dead)
+	assert.True(t, result.InstantDeath)
+	assert.False(t, result.Dying)
+
+	character = withHitPoints(t, testFighter(t, 3), 10, 0)
+	result, err = engine.ChangeHitPoints(character, &models.HitPointChangeRequest{Type: rules.ChangeDamage, Amount: 34})
+	require.NoError(t, err)
+	assert.False(t, result.Dead)
+}
+
+func TestEngine_ChangeHitPoints_Healing(t *testing.T) {
+	engine := newEngine(t)
+
+	character := withHitPoints(t, testFighter(t, 3), 0, 0)
+	character.DeathSaves = &models.DeathSaves{Successes: 1, Failures: 2}
+	result, err := engine.ChangeHitPoints(character, &models.HitPointChangeRequest{Type: rules.ChangeHealing, Amount: 50})
+	require.NoError(t, err)
+
+	assert.Equal(t, 25, result.Change)
+	assert.Equal(t, 25, character.HitPoints.Current)
+	assert.Equal(t, models.DeathSaves{}, *character.DeathSaves)
+	assert.False(t, result.Dying)
+}
+
+func TestEngine_ChangeHitPoints_TemporaryDoNotStack(t *testing.T) {
+	engine := newEngine(t)
+
+	character := withHitPoints(t, testFighter(t, 3), 25, 6)
+	result, err := engine.ChangeHitPoints(character, &models.HitPointChangeRequest{Type: rules.ChangeTemporary, Amount: 4})
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Change)
+	assert.Equal(t, 6, character.HitPoints.Temporary)
+
+	result, err = engine.ChangeHitPoints(character, &models.HitPointChangeRequest{Type: rules.ChangeTemporary, Amount: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 4, result.Change)
+	assert.Equal(t, 10, character.HitPoints.Temporary)
+}
@@ -0,0 +1,118 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
+)
+
+func TestEngine_DeathSave(t *testing.T) {
+	tests := []struct {
+		name     string
+		saves    models.DeathSaves
+		roll     int
+		outcome  string
+		status   string
+		expected models.DeathSaves
+		current  int
+	}{
+		{
+			name:     "ten succeeds",
+			roll:     10,
+			outcome:  rules.SaveSuccess,
+			status:   rules.StatusDying,
+			expected: models.DeathSaves{Successes: 1},
+		},
+		{
+			name:     "nine fails",
+			roll:     9,
+			outcome:  rules.SaveFailure,
+			status:   rules.StatusDying,
+			expected: models.DeathSaves{Failures: 1},
+		},
+		{
+			name:     "natural 1 counts as two failures",
+			saves:    models.DeathSaves{Successes: 2},
+			roll:     1,
+			outcome:  rules.SaveCriticalFailure,
+			status:   rules.StatusDying,
+			expected: models.DeathSaves{Successes: 2, Failures: 2},
+		},
+		{
+			name:     "natural 20 regains 1 hit point",
+			saves:    models.DeathSaves{Successes: 1, Failures: 2},
+			roll:     20,
+			outcome:  rules.SaveCriticalSuccess,
+			status:   rules.StatusConscious,
+			expected: models.DeathSaves{},
+			current:  1,
+		},
+		{
+			name:     "third success stabilizes",
+			saves:    models.DeathSaves{Successes: 2, Failures: 1},
+			roll:     15,
+			outcome:  rules.SaveSuccess,
+			status:   rules.StatusStable,
+			expected: models.DeathSaves{Stable: true},
+		},
+		{
+			name:     "third failure is death",
+			saves:    models.DeathSaves{Failures: 2},
+			roll:     4,
+			outcome:  rules.SaveFailure,
+			status:   rules.StatusDead,
+			expected: models.DeathSaves{Failures: 3, Dead: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := withHitPoints(t, testFighter(t, 3), 0, 0)
+			*character.DeathSaves = tt.saves
+
+			result, err := newEngine(t).DeathSave(character, rollsOf(tt.roll))
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.roll, result.Roll)
+			assert.Equal(t, tt.outcome, result.Outcome)
+			assert.Equal(t, tt.status, result.Status)
+			assert.Equal(t, tt.status, character.Status)
+			assert.Equal(t, tt.expected, *character.DeathSaves)
+			assert.Equal(t, tt.current, character.HitPoints.Current)
+		})
+	}
+}
+
+func TestEngine_DeathSave_Rejections(t *testing.T) {
+	engine := newEngine(t)
+
+	_, err := engine.DeathSave(withHitPoints(t, testFighter(t, 3), 5, 0), rollsOf(10))
+	assert.EqualError(t, err, "character is not dying")
+
+	character := withHitPoints(t, testFighter(t, 3), 0, 0)
+	character.DeathSaves.Stable = true
+	_, err = engine.DeathSave(character, rollsOf(10))
+	assert.EqualError(t, err, "character is stable and does not make death saving throws")
+
+	character.DeathSaves.Dead = true
+	_, err = engine.DeathSave(character, rollsOf(10))
+	assert.EqualError(t, err, "character is dead")
+}
+
+func TestEngine_DeathSave_DamageWhileStable(t *testing.T) {
+	engine := newEngine(t)
+
+	character := withHitPoints(t, testFighter(t, 3), 0, 0)
+	character.DeathSaves.Stable = true
+	engine.Apply(character)
+	assert.Equal(t, rules.StatusStable, character.Status)
+
+	result, err := engine.ChangeHitPoints(character, &models.HitPointChangeRequest{Type: rules.ChangeDamage, Amount: 2})
+	require.NoError(t, err)
+	assert.True(t, result.Dying)
+	assert.Equal(t, models.DeathSaves{Failures: 1}, *character.DeathSaves)
+	assert.Equal(t, rules.StatusDying, character.Status)
+}
@@ -0,0 +1,70 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/config"
+	"github.com/yourusername/dnd-character-creator/internal/reference"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
+)
+
+func TestEngine_CarriedWeight(t *testing.T) {
+	engine := newEngine(t)
+
+	// 55 (chain mail) + 6 (greatsword) + 20 (rations) + 10 (500 coins)
+	assert.Equal(t, 91.0, engine.CarriedWeight(loadedFighter(t, 10, 10).Inventory))
+}
+
+func TestEngine_Apply_VariantEncumbrance(t *testing.T) {
+	engine := newEngine(t)
+
+	tests := []struct {
+		name     string
+		strength int
+		rations  int
+		status   string
+		walk     int
+		swim     int
+	}{
+		{"unencumbered", 16, 0, rules.EncumbranceNone, 30, 15},
+		{"encumbered", 10, 10, rules.EncumbranceEncumbered, 20, 5},
+		{"heavily encumbered", 10, 20, rules.EncumbranceHeavy, 10, 0},
+		{"over capacity", 6, 10, rules.EncumbranceOverCapacity, 5, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := loadedFighter(t, tt.strength, tt.rations)
+
+			engine.Apply(character)
+
+			assert.Equal(t, tt.status, character.Encumbrance.Status)
+			assert.Equal(t, tt.walk, character.EffectiveSpeed.Walk)
+			assert.Equal(t, tt.swim, character.EffectiveSpeed.Swim)
+			assert.Equal(t, 30, character.Speed.Walk, "base speed is never modified")
+		})
+	}
+}
+
+func TestEngine_Apply_StandardEncumbranceHasNoPenalty(t *testing.T) {
+	catalog, err := reference.Load()
+	require.NoError(t, err)
+	engine := rules.NewEngine(catalog, config.RulesConfig{VariantEncumbrance: false})
+
+	character := loadedFighter(t, 10, 10)
+	engine.Apply(character)
+
+	assert.Equal(t, rules.EncumbranceEncumbered, character.Encumbrance.Status)
+	assert.Equal(t, 0, character.Encumbrance.SpeedPenalty)
+	assert.Equal(t, 30, character.EffectiveSpeed.Walk)
+	assert.Equal(t, 150, character.Inventory.CarryingCapacity)
+}
+
+func TestSizeMultiplier(t *testing.T) {
+	assert.Equal(t, 0.5, rules.SizeMultiplier("Tiny"))
+	assert.Equal(t, 1.0, rules.SizeMultiplier("Small"))
+	assert.Equal(t, 1.0, rules.SizeMultiplier("Medium"))
+	assert.Equal(t, 2.0, rules.SizeMultiplier("Large"))
+}
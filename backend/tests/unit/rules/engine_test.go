@@ -0,0 +1,80 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yourusername/dnd-character-creator/internal/config"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
+)
+
+func TestAbilityModifier_FloorSemantics(t *testing.T) {
+	tests := []struct {
+		score    int
+		modifier int
+	}{
+		{1, -5},
+		{2, -4},
+		{3, -4},
+		{8, -1},
+		{9, -1},
+		{10, 0},
+		{11, 0},
+		{15, 2},
+		{30, 10},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.modifier, rules.AbilityModifier(tt.score), "score %d", tt.score)
+	}
+}
+
+func TestProficiencyBonus_Progression(t *testing.T) {
+	tests := []struct {
+		level int
+		bonus int
+	}{
+		{1, 2},
+		{4, 2},
+		{5, 3},
+		{8, 3},
+		{9, 4},
+		{13, 5},
+		{17, 6},
+		{20, 6},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.bonus, rules.ProficiencyBonus(tt.level), "level %d", tt.level)
+	}
+}
+
+func TestSkillModifier(t *testing.T) {
+	assert.Equal(t, 2, rules.SkillModifier(2, 3, models.Skill{}))
+	assert.Equal(t, 5, rules.SkillModifier(2, 3, models.Skill{Proficient: true}))
+	assert.Equal(t, 8, rules.SkillModifier(2, 3, models.Skill{Proficient: true, Expertise: true}))
+}
+
+func TestEngine_Apply_Spellcasting(t *testing.T) {
+	engine := rules.NewEngine(nil, config.RulesConfig{})
+
+	character := &models.Character{
+		Level: 9,
+		AbilityScores: models.AbilityScores{
+			Strength:     models.AbilityScore{Score: 10},
+			Dexterity:    models.AbilityScore{Score: 10},
+			Constitution: models.AbilityScore{Score: 10},
+			Intelligence: models.AbilityScore{Score: 18},
+			Wisdom:       models.AbilityScore{Score: 10},
+			Charisma:     models.AbilityScore{Score: 10},
+		},
+		Spellcasting: &models.Spellcasting{SpellcastingAbility: "Intelligence"},
+	}
+
+	engine.Apply(character)
+
+	assert.Equal(t, 4, character.ProficiencyBonus)
+	assert.Equal(t, 16, character.Spellcasting.SpellSaveDC)
+	assert.Equal(t, 8, character.Spellcasting.SpellAttackBonus)
+}
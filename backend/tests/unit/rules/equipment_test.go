@@ -0,0 +1,236 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/reference"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
+)
+
+func TestEngine_Equip(t *testing.T) {
+	tests := []struct {
+		name     string
+		items    []models.InventoryItem
+		equip    string
+		request  models.EquipRequest
+		expected string
+	}{
+		{
+			name:  "armor",
+			items: []models.InventoryItem{{ID: "a", ItemID: "chain-mail", Quantity: 1}},
+			equip: "a",
+		},
+		{
+			name: "second body armor",
+			items: []models.InventoryItem{
+				{ID: "a", ItemID: "chain-mail", Quantity: 1, Equipped: true},
+				{ID: "b", ItemID: "leather-armor", Quantity: 1},
+			},
+			equip:    "b",
+			expected: "cannot equip Leather Armor: only one body armor can be equipped at a time",
+		},
+		{
+			name: "shield with a two-handed weapon",
+			items: []models.InventoryItem{
+				{ID: "a", ItemID: "greatsword", Quantity: 1, Equipped: true},
+				{ID: "b", ItemID: "shield", Quantity: 1},
+			},
+			equip:    "b",
+			expected: "a two-handed weapon can't be wielded while holding a shield",
+		},
+		{
+			name: "weapon and shield",
+			items: []models.InventoryItem{
+				{ID: "a", ItemID: "longsword", Quantity: 1, Equipped: true},
+				{ID: "b", ItemID: "shield", Quantity: 1},
+			},
+			equip: "b",
+		},
+		{
+			name: "third weapon",
+			items: []models.InventoryItem{
+				{ID: "a", ItemID: "shortsword", Quantity: 1, Equipped: true},
+				{ID: "b", ItemID: "dagger", Quantity: 1, Equipped: true},
+				{ID: "c", ItemID: "handaxe", Quantity: 1},
+			},
+			equip:    "c",
+			expected: "equipped weapons and shields need 3 hands but a character only has 2",
+		},
+		{
+			name:     "attuning to a mundane item",
+			items:    []models.InventoryItem{{ID: "a", ItemID: "longsword", Quantity: 1}},
+			equip:    "a",
+			request:  models.EquipRequest{Attune: true},
+			expected: "Longsword does not require attunement",
+		},
+		{
+			name:     "unknown item",
+			equip:    "z",
+			expected: `character has no item "z"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := newEngine(t)
+			character := testFighter(t, 1, tt.items...)
+
+			err := engine.Equip(character, tt.equip, &tt.request)
+
+			if tt.expected != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expected)
+				for _, item := range character.Inventory.Items {
+					if item.ID == tt.equip {
+						assert.False(t, item.Equipped, "a rejected item stays unequipped")
+					}
+				}
+				return
+			}
+			require.NoError(t, err)
+			for _, item := range character.Inventory.Items {
+				if item.ID == tt.equip {
+					assert.True(t, item.Equipped)
+				}
+			}
+		})
+	}
+}
+
+func TestEngine_Equip_AttunementLimit(t *testing.T) {
+	engine := newEngine(t)
+	character := testFighter(t, 1, ringOfProtection("1"), ringOfProtection("2"), ringOfProtection("3"), ringOfProtection("4"))
+
+	for _, id := range []string{"1", "2", "3"} {
+		require.NoError(t, engine.Equip(character, id, &models.EquipRequest{Attune: true}))
+	}
+	err := engine.Equip(character, "4", &models.EquipRequest{Attune: true})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "a character can be attuned to at most 3 magic items")
+	assert.False(t, character.Inventory.Items[3].Attuned)
+
+	require.NoError(t, engine.Unequip(character, "1", &models.UnequipRequest{EndAttunement: true}))
+	require.NoError(t, engine.Equip(character, "4", &models.EquipRequest{Attune: true}))
+	assert.True(t, character.Inventory.Items[3].Attuned)
+}
+
+func TestEngine_Unequip(t *testing.T) {
+	engine := newEngine(t)
+	character := testFighter(t, 1,
+		models.InventoryItem{ID: "a", ItemID: "chain-mail", Quantity: 1, Equipped: true},
+		models.InventoryItem{ID: "b", ItemID: "dagger", Quantity: 1},
+	)
+	engine.Apply(character)
+	require.Equal(t, 16, character.ArmorClass)
+
+	require.NoError(t, engine.Unequip(character, "a", &models.UnequipRequest{}))
+	assert.False(t, character.Inventory.Items[0].Equipped)
+	assert.Equal(t, 11, character.ArmorClass)
+
+	err := engine.Unequip(character, "b", &models.UnequipRequest{})
+	require.Error(t, err)
+	assert.Equal(t, "Dagger is not equipped", err.Error())
+}
+
+func TestEngine_Apply_HeavyArmorStrengthRequirement(t *testing.T) {
+	tests := []struct {
+		name     string
+		race     string
+		strength int
+		walk     int
+	}{
+		{"meets the requirement", "Human", 13, 30},
+		{"below the requirement", "Human", 12, 20},
+		{"dwarves are not slowed", "Dwarf", 12, 25},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := newEngine(t)
+			character := testFighter(t, 1, models.InventoryItem{ItemID: "chain-mail", Quantity: 1, Equipped: true})
+			character.Race = tt.race
+			character.Speed.Walk = 30
+			if tt.race == "Dwarf" {
+				character.Speed.Walk = 25
+			}
+			character.AbilityScores.Strength.Score = tt.strength
+
+			engine.Apply(character)
+
+			assert.Equal(t, tt.walk, character.EffectiveSpeed.Walk)
+		})
+	}
+}
+
+func TestEngine_Apply_WeaponAttacks(t *testing.T) {
+	engine := newEngine(t)
+	character := testFighter(t, 1,
+		models.InventoryItem{ID: "a", ItemID: "longsword", Quantity: 1, Equipped: true},
+		models.InventoryItem{ID: "b", ItemID: "rapier", Quantity: 1},
+	)
+	character.AbilityScores.Strength.Score = 16
+	character.Attacks = []models.Attack{
+		{Name: "Unarmed Strike", AttackBonus: 5, Damage: "1+3", DamageType: "bludgeoning"},
+		{Name: "Stale", ItemID: "b"},
+	}
+
+	engine.Apply(character)
+
+	require.Len(t, character.Attacks, 2)
+	assert.Equal(t, "Unarmed Strike", character.Attacks[0].Name)
+	assert.Equal(t, models.Attack{
+		Name:        "Longsword",
+		AttackBonus: 5,
+		Damage:      "1d8+3",
+		DamageType:  "slashing",
+		Notes:       "versatile (1d10+3)",
+		ItemID:      "a",
+	}, character.Attacks[1])
+}
+
+func TestEngine_Apply_WeaponAttackAbilities(t *testing.T) {
+	tests := []struct {
+		name   string
+		class  string
+		itemID string
+		bonus  int
+		damage string
+	}{
+		{"finesse uses the better of Strength and Dexterity", "Rogue", "rapier", 6, "1d8+4"},
+		{"ranged uses Dexterity", "Fighter", "longbow", 6, "1d8+4"},
+		{"melee uses Strength", "Fighter", "warhammer", 1, "1d8-1"},
+		{"no proficiency bonus without proficiency", "Wizard", "longsword", -1, "1d8-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := newEngine(t)
+			character := testFighter(t, 1, models.InventoryItem{ID: "a", ItemID: tt.itemID, Quantity: 1, Equipped: true})
+			character.Class = tt.class
+			character.AbilityScores.Strength.Score = 8
+			character.AbilityScores.Dexterity.Score = 18
+
+			engine.Apply(character)
+
+			require.Len(t, character.Attacks, 1)
+			assert.Equal(t, tt.bonus, character.Attacks[0].AttackBonus)
+			assert.Equal(t, tt.damage, character.Attacks[0].Damage)
+		})
+	}
+}
+
+func TestEquipmentErrors_FreeTextEntries(t *testing.T) {
+	catalog, err := reference.Load()
+	require.NoError(t, err)
+	inventory := &models.Inventory{
+		Weapons: []models.Weapon{{Name: "Greataxe", Quantity: 1, Equipped: true}},
+		Armor:   []models.ArmorItem{{Name: "Shield", Type: "Shield", ArmorClass: 2, Equipped: true}},
+	}
+
+	assert.Empty(t, rules.EquipmentErrors(nil, inventory), "without the catalog the greataxe's properties are unknown")
+	assert.Equal(t, []string{"a two-handed weapon can't be wielded while holding a shield"}, rules.EquipmentErrors(catalog, inventory))
+}
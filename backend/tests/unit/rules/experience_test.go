@@ -0,0 +1,90 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/config"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/reference"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
+)
+
+func TestLevelForExperience(t *testing.T) {
+	tests := []struct {
+		experience int
+		expected   int
+	}{
+		{0, 1},
+		{299, 1},
+		{300, 2},
+		{6500, 5},
+		{354999, 19},
+		{355000, 20},
+		{1000000, 20},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, rules.LevelForExperience(tt.experience), "experience %d", tt.experience)
+	}
+
+	assert.Equal(t, 0, rules.ExperienceForLevel(1))
+	assert.Equal(t, 2700, rules.ExperienceForLevel(4))
+	assert.Equal(t, 355000, rules.ExperienceForLevel(20))
+}
+
+func TestEngine_AwardExperience(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testCharacter("Fighter", 1)
+	result, err := engine.AwardExperience(character, models.ExperienceAward{Amount: 250, Reason: "Goblin ambush"})
+	require.NoError(t, err)
+	assert.False(t, result.ThresholdCrossed)
+	assert.False(t, result.LevelUpAvailable)
+	assert.Equal(t, 300, result.NextLevelExperience)
+
+	result, err = engine.AwardExperience(character, models.ExperienceAward{Amount: 700, Reason: "Cragmaw hideout", Encounter: "klarg"})
+	require.NoError(t, err)
+	assert.True(t, result.ThresholdCrossed)
+	assert.True(t, result.LevelUpAvailable)
+	assert.True(t, character.LevelUpAvailable)
+	assert.Equal(t, 3, result.EligibleLevel)
+	assert.Equal(t, 2700, result.NextLevelExperience)
+	assert.Equal(t, 950, character.ExperiencePoints)
+	require.Len(t, character.ExperienceLedger, 2)
+	assert.Equal(t, 950, character.ExperienceLedger[1].Total)
+	assert.Equal(t, "klarg", character.ExperienceLedger[1].Encounter)
+
+	// Corrections never take the total below zero
+	_, err = engine.AwardExperience(character, models.ExperienceAward{Amount: -2000, Reason: "Correction"})
+	require.NoError(t, err)
+	assert.Equal(t, 0, character.ExperiencePoints)
+	assert.False(t, character.LevelUpAvailable)
+
+	_, err = engine.AwardExperience(character, models.ExperienceAward{Amount: 0, Reason: "Nothing"})
+	assert.EqualError(t, err, "experience award amount must not be zero")
+}
+
+func TestEngine_AwardExperience_Milestone(t *testing.T) {
+	character := testCharacter("Fighter", 1)
+	character.Advancement = rules.AdvancementMilestone
+
+	_, err := newEngine(t).AwardExperience(character, models.ExperienceAward{Amount: 300, Reason: "Goblin ambush"})
+	assert.EqualError(t, err, "character uses milestone levelling and does not track experience points")
+	assert.Empty(t, character.ExperienceLedger)
+
+	catalog, err := reference.Load()
+	require.NoError(t, err)
+	milestone := rules.NewEngine(catalog, config.RulesConfig{AdvancementMode: rules.AdvancementMilestone})
+
+	character = testCharacter("Fighter", 1)
+	character.ExperiencePoints = 900
+	milestone.Apply(character)
+	assert.False(t, character.LevelUpAvailable)
+
+	// A character can opt back into experience on a milestone deployment
+	character.Advancement = rules.AdvancementXP
+	milestone.Apply(character)
+	assert.True(t, character.LevelUpAvailable)
+}
@@ -0,0 +1,161 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/config"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/reference"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
+)
+
+func newEngine(t *testing.T) *rules.Engine {
+	catalog, err := reference.Load()
+	require.NoError(t, err)
+	return rules.NewEngine(catalog, config.RulesConfig{VariantEncumbrance: true})
+}
+
+func testCharacter(class string, level int, multiclass ...models.MulticlassEntry) *models.Character {
+	return &models.Character{
+		Class:      class,
+		Level:      level,
+		Multiclass: multiclass,
+		AbilityScores: models.AbilityScores{
+			Strength:     models.AbilityScore{Score: 13},
+			Dexterity:    models.AbilityScore{Score: 13},
+			Constitution: models.AbilityScore{Score: 13},
+			Intelligence: models.AbilityScore{Score: 13},
+			Wisdom:       models.AbilityScore{Score: 13},
+			Charisma:     models.AbilityScore{Score: 13},
+		},
+	}
+}
+
+// testFighter is a human Fighter on full hit points holding items, with its
+// derived stats applied
+func testFighter(t *testing.T, level int, items ...models.InventoryItem) *models.Character {
+	engine := newEngine(t)
+	character := testCharacter("Fighter", level)
+	character.Race = "Human"
+	character.Speed = models.Speed{Walk: 30}
+	character.Inventory = &models.Inventory{Items: items}
+	engine.Apply(character)
+	character.HitPoints.Current = character.HitPoints.Maximum
+	engine.Apply(character)
+	return character
+}
+
+// withHitPoints sets a character's current and temporary hit points and
+// reapplies its derived stats
+func withHitPoints(t *testing.T, character *models.Character, current, temporary int) *models.Character {
+	character.HitPoints.Current = current
+	character.HitPoints.Temporary = temporary
+	newEngine(t).Apply(character)
+	return character
+}
+
+// loadedFighter carries chain mail rather than wearing it, so its Strength
+// requirement doesn't slow them, along with a greatsword, rations and 500 gold
+func loadedFighter(t *testing.T, strength int, rations int) *models.Character {
+	character := testFighter(t, 1)
+	character.AbilityScores.Strength.Score = strength
+	character.Speed.Swim = 15
+	character.Inventory = &models.Inventory{
+		Currency:  &models.Currency{Gold: 500},
+		Weapons:   []models.Weapon{{Name: "Greatsword", Quantity: 1}},
+		Armor:     []models.ArmorItem{{Name: "Chain Mail", Type: "Heavy Armor", ArmorClass: 16}},
+		Equipment: []models.EquipmentItem{{Name: "Rations (1 day)", Quantity: rations, Weight: 2}},
+	}
+	return character
+}
+
+// testWizard is a level 3 Wizard with spells known and prepared
+func testWizard(t *testing.T) *models.Character {
+	character := testCharacter("Wizard", 3)
+	character.HitPoints.Current = 10
+	character.Spellcasting = &models.Spellcasting{
+		CantripsKnown:  []string{"Fire Bolt"},
+		SpellsKnown:    []string{"Find Familiar", "Detect Magic", "Magic Missile", "Shield", "Hold Person", "Blur"},
+		PreparedSpells: []string{"Magic Missile", "Shield", "Hold Person", "Blur"},
+	}
+	newEngine(t).Apply(character)
+	return character
+}
+
+// testParty is two level 1 Fighters, the first holding giverItems
+func testParty(t *testing.T, giverItems ...models.InventoryItem) (*models.Character, *models.Character) {
+	giver := testFighter(t, 1, giverItems...)
+	giver.ID = "giver"
+	giver.CharacterName = "Giver"
+	receiver := testFighter(t, 1)
+	receiver.ID = "receiver"
+	receiver.CharacterName = "Receiver"
+	return giver, receiver
+}
+
+func baseScores(values ...int) models.AbilityScores {
+	return models.AbilityScores{
+		Strength:     models.AbilityScore{Base: values[0]},
+		Dexterity:    models.AbilityScore{Base: values[1]},
+		Constitution: models.AbilityScore{Base: values[2]},
+		Intelligence: models.AbilityScore{Base: values[3]},
+		Wisdom:       models.AbilityScore{Base: values[4]},
+		Charisma:     models.AbilityScore{Base: values[5]},
+	}
+}
+
+// rollsOf returns a die roller that rolls values in order
+func rollsOf(values ...int) func(int) int {
+	return func(int) int {
+		value := values[0]
+		values = values[1:]
+		return value
+	}
+}
+
+// magicItem is a homebrew magic item held by a character
+func magicItem(id string, category string, magic models.MagicItem) models.InventoryItem {
+	return models.InventoryItem{
+		ID:       id,
+		ItemID:   "homebrew-" + id,
+		Quantity: 1,
+		Item:     &models.Item{ID: "homebrew-" + id, Name: id, Source: rules.ItemSourceHomebrew, Category: category, Magic: &magic},
+	}
+}
+
+// ringOfProtection is a homebrew ring that requires attunement and adds 1 to
+// Armor Class and saving throws
+func ringOfProtection(id string) models.InventoryItem {
+	ring := magicItem("Ring of Protection", "gear", models.MagicItem{
+		Rarity:             "rare",
+		RequiresAttunement: true,
+		Modifiers: []models.ItemModifier{
+			{Target: rules.ModifierArmorClass, Value: 1},
+			{Target: rules.ModifierSavingThrows, Value: 1},
+		},
+	})
+	ring.ID = id
+	return ring
+}
+
+func wandOfMagicMissiles(recharge string, regain string) models.InventoryItem {
+	return magicItem("Wand of Magic Missiles", "gear", models.MagicItem{
+		Rarity:  "uncommon",
+		Charges: &models.ItemCharges{Maximum: 7, Recharge: recharge, Regain: regain},
+	})
+}
+
+// bagOfHolding is a homebrew weightless container
+func bagOfHolding(id string) models.InventoryItem {
+	return models.InventoryItem{
+		ID:       id,
+		ItemID:   "bag-of-holding",
+		Quantity: 1,
+		Item: &models.Item{
+			ID: "bag-of-holding", Name: "Bag of Holding", Source: rules.ItemSourceHomebrew, Category: "gear", Weight: 15,
+			Container: &models.ItemContainer{Capacity: 500, Weightless: true},
+			Magic:     &models.MagicItem{Rarity: "uncommon"},
+		},
+	}
+}
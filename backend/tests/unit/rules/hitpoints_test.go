@@ -0,0 +1,170 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
+)
+
+func TestAverageHitDie(t *testing.T) {
+	assert.Equal(t, 4, rules.AverageHitDie(6))
+	assert.Equal(t, 5, rules.AverageHitDie(8))
+	assert.Equal(t, 6, rules.AverageHitDie(10))
+	assert.Equal(t, 7, rules.AverageHitDie(12))
+}
+
+func TestEngine_Apply_HitPointsFixedGrowth(t *testing.T) {
+	engine := newEngine(t)
+
+	// Fighter 5 with Con 14: 10 + 4 * 6 + 5 * 2
+	character := testCharacter("Fighter", 5)
+	character.AbilityScores.Constitution.Score = 14
+	character.HitPoints.Current = 100
+
+	engine.Apply(character)
+
+	assert.Equal(t, 44, character.HitPoints.Maximum)
+	assert.Equal(t, 44, character.HitPoints.Current)
+	assert.Equal(t, rules.GrowthFixed, character.HitPoints.Growth)
+	require.Len(t, character.HitPoints.History, 5)
+	assert.Equal(t, models.HitPointLevel{Level: 1, Class: "Fighter", HitDie: 10, Roll: 10, Method: rules.HitPointMax}, character.HitPoints.History[0])
+	assert.Equal(t, models.HitPointLevel{Level: 5, Class: "Fighter", HitDie: 10, Roll: 6, Method: rules.HitPointAverage}, character.HitPoints.History[4])
+}
+
+func TestEngine_Apply_HitPointsRolledGrowth(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testCharacter("Wizard", 3)
+	character.HitPoints.Growth = rules.GrowthRolled
+	character.HitPoints.History = []models.HitPointLevel{
+		{Class: "Wizard", Roll: 6, Method: rules.HitPointRolled},
+		{Class: "Wizard", Roll: 1, Method: rules.HitPointRolled},
+		{Class: "Wizard", Roll: 9, Method: rules.HitPointRolled},
+	}
+
+	engine.Apply(character)
+
+	// 6 (max) + 1 + 4 (impossible roll replaced by the average), Con +1 each
+	assert.Equal(t, 14, character.HitPoints.Maximum)
+	assert.Equal(t, rules.HitPointRolled, character.HitPoints.History[1].Method)
+	assert.Equal(t, rules.HitPointAverage, character.HitPoints.History[2].Method)
+}
+
+func TestEngine_Apply_HitPointsConstitutionIsRetroactive(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testCharacter("Barbarian", 4)
+	engine.Apply(character)
+	before := character.HitPoints.Maximum
+
+	character.AbilityScores.Constitution.Score = 15
+	engine.Apply(character)
+
+	assert.Equal(t, before+4, character.HitPoints.Maximum)
+}
+
+func TestEngine_Apply_HitPointsMinimumOnePerLevel(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testCharacter("Wizard", 2)
+	character.AbilityScores.Constitution.Score = 1
+	character.HitPoints.History = []models.HitPointLevel{
+		{Class: "Wizard", Method: rules.HitPointMax},
+		{Class: "Wizard", Roll: 1, Method: rules.HitPointRolled},
+	}
+
+	engine.Apply(character)
+
+	// 6 - 5 at level 1, and a roll of 1 - 5 still grants 1
+	assert.Equal(t, 2, character.HitPoints.Maximum)
+}
+
+func TestEngine_Apply_HitPointsMulticlass(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testCharacter("Wizard", 3, models.MulticlassEntry{Class: "Fighter", Level: 1})
+	character.HitPoints.History = []models.HitPointLevel{
+		{Class: "Fighter", Roll: 7, Method: rules.HitPointRolled},
+		{Class: "Wizard", Method: rules.HitPointMax},
+	}
+
+	engine.Apply(character)
+
+	history := character.HitPoints.History
+	require.Len(t, history, 3)
+	assert.Equal(t, "Wizard", history[0].Class)
+	assert.Equal(t, rules.HitPointMax, history[0].Method)
+	assert.Equal(t, "Fighter", history[1].Class)
+	assert.Equal(t, 7, history[1].Roll)
+	assert.Equal(t, "Wizard", history[2].Class)
+	// 6 + 7 + 4, Con +1 each
+	assert.Equal(t, 20, character.HitPoints.Maximum)
+}
+
+func TestEngine_Apply_HitPointBonuses(t *testing.T) {
+	engine := newEngine(t)
+
+	tests := []struct {
+		name     string
+		modify   func(*models.Character)
+		expected int
+	}{
+		{"no bonus", func(*models.Character) {}, 0},
+		{"hill dwarf", func(c *models.Character) { c.Race, c.Subrace = "Dwarf", "Hill Dwarf" }, 4},
+		{"tough feat", func(c *models.Character) { c.Features = []models.Feature{{Name: "Tough"}} }, 8},
+		{"draconic resilience needs sorcerer levels", func(c *models.Character) {
+			c.Features = []models.Feature{{Name: "Draconic Resilience"}}
+		}, 0},
+	}
+
+	base := testCharacter("Fighter", 4)
+	engine.Apply(base)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := testCharacter("Fighter", 4)
+			tt.modify(character)
+			engine.Apply(character)
+			assert.Equal(t, base.HitPoints.Maximum+tt.expected, character.HitPoints.Maximum)
+		})
+	}
+}
+
+func TestEngine_Apply_DraconicResilienceHitPoints(t *testing.T) {
+	engine := newEngine(t)
+
+	plain := testCharacter("Sorcerer", 3)
+	engine.Apply(plain)
+
+	draconic := testCharacter("Sorcerer", 3)
+	draconic.Subclass = "Draconic Bloodline"
+	engine.Apply(draconic)
+	assert.Equal(t, plain.HitPoints.Maximum+3, draconic.HitPoints.Maximum)
+
+	// The feature recorded by a level up does not count twice
+	draconic.Features = []models.Feature{{Name: "Draconic Resilience"}}
+	engine.Apply(draconic)
+	assert.Equal(t, plain.HitPoints.Maximum+3, draconic.HitPoints.Maximum)
+}
+
+func TestPreserveHitPointHistory(t *testing.T) {
+	recorded := []models.HitPointLevel{
+		{Level: 1, Class: "Fighter", Roll: 10, Method: rules.HitPointMax},
+		{Level: 2, Class: "Fighter", Roll: 3, Method: rules.HitPointRolled},
+	}
+	updated := []models.HitPointLevel{
+		{Level: 1, Class: "Fighter", Roll: 10, Method: rules.HitPointMax},
+		{Level: 2, Class: "Fighter", Roll: 10, Method: rules.HitPointRolled},
+		{Level: 3, Class: "Fighter", Roll: 8, Method: rules.HitPointRolled},
+	}
+
+	history := rules.PreserveHitPointHistory(recorded, updated)
+
+	require.Len(t, history, 3)
+	assert.Equal(t, 3, history[1].Roll)
+	assert.Equal(t, 8, history[2].Roll)
+	assert.Equal(t, updated, rules.PreserveHitPointHistory(nil, updated))
+}
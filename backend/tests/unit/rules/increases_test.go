@@ -0,0 +1,108 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
+)
+
+func TestEngine_Apply_RacialIncreases2014(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testCharacter("Fighter", 1)
+	character.Race = "Dwarf"
+	character.Subrace = "Hill Dwarf"
+	character.AbilityScores = baseScores(15, 14, 13, 12, 10, 8)
+
+	engine.Apply(character)
+
+	assert.Equal(t, rules.Rules2014, character.AbilityScores.RulesVersion)
+	assert.Equal(t, 15, character.AbilityScores.Constitution.Score)
+	assert.Equal(t, 2, character.AbilityScores.Constitution.Modifier)
+	assert.Equal(t, 11, character.AbilityScores.Wisdom.Score)
+	assert.Equal(t, 13, character.AbilityScores.Constitution.Base)
+}
+
+func TestEngine_Apply_RaceSwapKeepsBaseScores(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testCharacter("Fighter", 1)
+	character.Race = "Dwarf"
+	character.AbilityScores = baseScores(15, 14, 13, 12, 10, 8)
+	engine.Apply(character)
+	assert.Equal(t, 15, character.AbilityScores.Constitution.Score)
+
+	character.Race = "Elf"
+	engine.Apply(character)
+
+	assert.Equal(t, 13, character.AbilityScores.Constitution.Score)
+	assert.Equal(t, 16, character.AbilityScores.Dexterity.Score)
+}
+
+func TestEngine_Apply_BackgroundIncreases2024(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testCharacter("Wizard", 1)
+	character.Race = "Dwarf"
+	character.Background = "Acolyte"
+	character.AbilityScores = baseScores(8, 14, 13, 15, 12, 10)
+	character.AbilityScores.RulesVersion = rules.Rules2024
+	character.AbilityScores.Increases = []models.AbilityScoreIncrease{
+		{Ability: "intelligence", Amount: 2, Source: rules.IncreaseBackground},
+		{Ability: "wis", Amount: 1, Source: rules.IncreaseBackground},
+	}
+
+	engine.Apply(character)
+
+	assert.Equal(t, 17, character.AbilityScores.Intelligence.Score)
+	assert.Equal(t, 13, character.AbilityScores.Wisdom.Score)
+	// No racial bonus under the 2024 rules
+	assert.Equal(t, 13, character.AbilityScores.Constitution.Score)
+}
+
+func TestEngine_Apply_IncreasesCapAndOverrides(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testCharacter("Fighter", 8)
+	character.Race = "Human"
+	character.AbilityScores = baseScores(18, 14, 13, 12, 10, 8)
+	character.AbilityScores.Increases = []models.AbilityScoreIncrease{
+		{Ability: "strength", Amount: 2, Source: rules.IncreaseLevelUp, Level: 4},
+		{Ability: "dexterity", Amount: 2, Source: rules.IncreaseLevelUp, Level: 6},
+	}
+	character.AbilityScores.Overrides = []models.AbilityScoreOverride{
+		{Ability: "constitution", Score: 19, Source: "Amulet of Health"},
+		{Ability: "dexterity", Score: 15, Source: "Lesser Charm"},
+	}
+
+	engine.Apply(character)
+
+	// 18 + 1 (human) + 2 is capped at 20
+	assert.Equal(t, 20, character.AbilityScores.Strength.Score)
+	// Overrides raise a score but never lower it
+	assert.Equal(t, 19, character.AbilityScores.Constitution.Score)
+	assert.Equal(t, 17, character.AbilityScores.Dexterity.Score)
+}
+
+func TestEngine_Apply_LegacyScoresUnchanged(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testCharacter("Fighter", 1)
+	character.Race = "Dwarf"
+
+	engine.Apply(character)
+
+	assert.Equal(t, 13, character.AbilityScores.Constitution.Score)
+	assert.Empty(t, character.AbilityScores.RulesVersion)
+}
+
+func TestAbilityScoreImprovements(t *testing.T) {
+	assert.Equal(t, 0, rules.AbilityScoreImprovements("wizard", 3))
+	assert.Equal(t, 2, rules.AbilityScoreImprovements("wizard", 8))
+	assert.Equal(t, 5, rules.AbilityScoreImprovements("wizard", 20))
+	assert.Equal(t, 3, rules.AbilityScoreImprovements("fighter", 8))
+	assert.Equal(t, 7, rules.AbilityScoreImprovements("fighter", 20))
+	assert.Equal(t, 3, rules.AbilityScoreImprovements("rogue", 10))
+}
@@ -0,0 +1,90 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
+)
+
+func TestEngine_Apply_ResolvesCatalogItems(t *testing.T) {
+	engine := newEngine(t)
+	character := testFighter(t, 1, models.InventoryItem{ItemID: "longsword", Quantity: 1})
+
+	engine.Apply(character)
+
+	item := character.Inventory.Items[0]
+	assert.NotEmpty(t, item.ID)
+	require.NotNil(t, item.Item)
+	assert.Equal(t, "Longsword", item.Item.Name)
+	assert.Equal(t, rules.ItemSourceSRD, item.Item.Source)
+	assert.Equal(t, "1d8", item.Item.Damage)
+}
+
+func TestEngine_Apply_KeepsInventoryItemIDs(t *testing.T) {
+	engine := newEngine(t)
+	character := testFighter(t, 1, models.InventoryItem{ID: "abc", ItemID: "dagger", Quantity: 2})
+
+	engine.Apply(character)
+
+	assert.Equal(t, "abc", character.Inventory.Items[0].ID)
+}
+
+func TestEngine_Apply_UnknownItemIsUnresolved(t *testing.T) {
+	engine := newEngine(t)
+	character := testFighter(t, 1, models.InventoryItem{ItemID: "vorpal-spoon", Quantity: 1})
+
+	engine.Apply(character)
+
+	assert.Nil(t, character.Inventory.Items[0].Item)
+	assert.Equal(t, 0.0, engine.CarriedWeight(character.Inventory))
+}
+
+func TestEngine_CarriedWeight_Items(t *testing.T) {
+	engine := newEngine(t)
+	character := testFighter(t, 1,
+		models.InventoryItem{ItemID: "chain-mail", Quantity: 1},
+		models.InventoryItem{ItemID: "dagger", Quantity: 3},
+	)
+
+	// 55 (chain mail) + 3 x 1 (daggers)
+	assert.Equal(t, 58.0, engine.CarriedWeight(character.Inventory))
+}
+
+func TestEngine_Apply_ArmorClassFromItems(t *testing.T) {
+	engine := newEngine(t)
+	character := testFighter(t, 1,
+		models.InventoryItem{ItemID: "chain-mail", Quantity: 1, Equipped: true, Name: "Dented Chain Mail"},
+		models.InventoryItem{ItemID: "shield", Quantity: 1, Equipped: true},
+	)
+
+	engine.Apply(character)
+
+	assert.Equal(t, 18, character.ArmorClass)
+	require.Len(t, character.ArmorClassBreakdown, 2)
+	assert.Equal(t, "Dented Chain Mail", character.ArmorClassBreakdown[0].Source)
+	assert.Equal(t, "Shield", character.ArmorClassBreakdown[1].Source)
+}
+
+func TestEngine_Apply_HomebrewItemStats(t *testing.T) {
+	engine := newEngine(t)
+	maxDex := 2
+	character := testFighter(t, 1, models.InventoryItem{
+		ItemID:   "dragon-scale",
+		Quantity: 1,
+		Equipped: true,
+		Item: &models.Item{
+			ID: "dragon-scale", Name: "Dragon Scale", Source: rules.ItemSourceHomebrew, Category: "armor",
+			ArmorCategory: "medium", BaseArmorClass: 15, DexBonus: true, MaxDexBonus: &maxDex, Weight: 20,
+		},
+	})
+	character.AbilityScores.Dexterity.Score = 14
+
+	engine.Apply(character)
+
+	assert.Equal(t, 17, character.ArmorClass)
+	assert.Equal(t, "Dragon Scale", rules.ItemName(&character.Inventory.Items[0]))
+	assert.Equal(t, 20.0, engine.CarriedWeight(character.Inventory))
+}
@@ -0,0 +1,245 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
+)
+
+func TestEngine_LevelUpOptions(t *testing.T) {
+	engine := newEngine(t)
+
+	tests := []struct {
+		name      string
+		character *models.Character
+		class     string
+		check     func(t *testing.T, options *models.LevelUpOptions)
+	}{
+		{
+			name:      "fighter 3 unlocks an archetype",
+			character: testCharacter("Fighter", 2),
+			class:     "Fighter",
+			check: func(t *testing.T, options *models.LevelUpOptions) {
+				assert.Equal(t, 3, options.ClassLevel)
+				assert.True(t, options.SubclassRequired)
+				assert.Contains(t, options.Subclasses, "Champion")
+				assert.False(t, options.AbilityScoreImprovement)
+			},
+		},
+		{
+			name:      "fighter 6 grants an extra improvement",
+			character: testCharacter("Fighter", 5),
+			class:     "Fighter",
+			check: func(t *testing.T, options *models.LevelUpOptions) {
+				assert.True(t, options.AbilityScoreImprovement)
+			},
+		},
+		{
+			name:      "wizard 4 learns a cantrip and two spells",
+			character: testCharacter("Wizard", 3),
+			class:     "Wizard",
+			check: func(t *testing.T, options *models.LevelUpOptions) {
+				assert.Equal(t, 1, options.Cantrips)
+				assert.Equal(t, 2, options.Spells)
+				assert.Equal(t, 2, options.MaxSpellLevel)
+				assert.True(t, options.AbilityScoreImprovement)
+			},
+		},
+		{
+			name:      "multiclassing into cleric requires a domain at level 1",
+			character: testCharacter("Fighter", 3),
+			class:     "Cleric",
+			check: func(t *testing.T, options *models.LevelUpOptions) {
+				assert.True(t, options.Multiclassing)
+				assert.True(t, options.SubclassRequired)
+				assert.Equal(t, 3, options.Cantrips)
+				assert.Equal(t, 0, options.Spells)
+				assert.Contains(t, options.Features, "Spellcasting")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options, err := engine.LevelUpOptions(tt.character, tt.class)
+			require.NoError(t, err)
+			tt.check(t, options)
+		})
+	}
+}
+
+func TestEngine_LevelUpOptions_Rejections(t *testing.T) {
+	engine := newEngine(t)
+
+	maxed := testCharacter("Fighter", 20)
+	_, err := engine.LevelUpOptions(maxed, "Fighter")
+	assert.EqualError(t, err, "character is already level 20")
+
+	weak := testCharacter("Fighter", 3)
+	weak.AbilityScores.Wisdom.Score = 10
+	engine.Apply(weak)
+	_, err = engine.LevelUpOptions(weak, "Monk")
+	assert.EqualError(t, err, "Monk requires dexterity 13 and wisdom 13 to multiclass")
+
+	_, err = engine.LevelUpOptions(testCharacter("Fighter", 3), "Gunslinger")
+	assert.EqualError(t, err, `class "Gunslinger" is not in the reference catalog`)
+}
+
+func TestEngine_LevelUp_SubclassAndFeatures(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testCharacter("Fighter", 2)
+	_, err := engine.LevelUp(character, &models.LevelUpRequest{Class: "Fighter"}, nil)
+	assert.ErrorContains(t, err, "a subclass must be chosen at Fighter level 3")
+	assert.Equal(t, 2, character.Level)
+
+	result, err := engine.LevelUp(character, &models.LevelUpRequest{Class: "Fighter", Subclass: "Champion"}, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, character.Level)
+	assert.Equal(t, 3, character.ClassLevel)
+	assert.Equal(t, "Champion", character.Subclass)
+	assert.Contains(t, result.Features, models.Feature{Name: "Improved Critical", Source: "Champion 3"})
+	assert.Contains(t, character.Features, models.Feature{Name: "Improved Critical", Source: "Champion 3"})
+	// Average of a d10 plus Con +1
+	assert.Equal(t, 7, result.HitPointIncrease)
+	assert.Equal(t, rules.HitPointAverage, result.HitPoints.Method)
+	assert.Equal(t, 3, result.HitPoints.Level)
+}
+
+func TestEngine_LevelUp_RolledHitPoints(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testCharacter("Barbarian", 1)
+	character.HitPoints.Growth = rules.GrowthRolled
+	engine.Apply(character)
+	character.HitPoints.Current = character.HitPoints.Maximum
+
+	result, err := engine.LevelUp(character, &models.LevelUpRequest{Class: "Barbarian"}, func(hitDie int) int {
+		assert.Equal(t, 12, hitDie)
+		return 3
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, models.HitPointLevel{Level: 2, Class: "Barbarian", HitDie: 12, Roll: 3, Method: rules.HitPointRolled}, result.HitPoints)
+	assert.Equal(t, 4, result.HitPointIncrease)
+	assert.Equal(t, 17, character.HitPoints.Maximum)
+	assert.Equal(t, 17, character.HitPoints.Current)
+}
+
+func TestEngine_LevelUp_AbilityScoreImprovement(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testCharacter("Rogue", 3)
+	character.Subclass = "Thief"
+	character.AbilityScores = baseScores(8, 15, 14, 12, 10, 10)
+
+	_, err := engine.LevelUp(character, &models.LevelUpRequest{Class: "Rogue"}, nil)
+	assert.ErrorContains(t, err, "an Ability Score Improvement requires increases totalling 2 or a feat")
+
+	_, err = engine.LevelUp(character, &models.LevelUpRequest{
+		Class:                 "Rogue",
+		AbilityScoreIncreases: []models.LevelUpIncrease{{Ability: "dex", Amount: 2}},
+		Feat:                  "Grappler",
+	}, nil)
+	assert.ErrorContains(t, err, "choose either ability score increases or a feat, not both")
+
+	_, err = engine.LevelUp(character, &models.LevelUpRequest{
+		Class:                 "Rogue",
+		AbilityScoreIncreases: []models.LevelUpIncrease{{Ability: "str", Amount: 3}, {Ability: "dex", Amount: -1}},
+	}, nil)
+	assert.ErrorContains(t, err, "abilityScoreIncreases[0].amount must be 1 or 2")
+	assert.ErrorContains(t, err, "abilityScoreIncreases[1].amount must be 1 or 2")
+
+	_, err = engine.LevelUp(character, &models.LevelUpRequest{
+		Class:                 "Rogue",
+		AbilityScoreIncreases: []models.LevelUpIncrease{{Ability: "str", Amount: 2}, {Ability: "dex", Amount: 0}},
+	}, nil)
+	assert.ErrorContains(t, err, "abilityScoreIncreases[1].amount must be 1 or 2")
+
+	_, err = engine.LevelUp(character, &models.LevelUpRequest{
+		Class:                 "Rogue",
+		AbilityScoreIncreases: []models.LevelUpIncrease{{Ability: "dex", Amount: 1}, {Ability: "dexterity", Amount: 1}},
+	}, nil)
+	assert.ErrorContains(t, err, `abilityScoreIncreases[1].ability "dexterity" is already raised`)
+
+	_, err = engine.LevelUp(character, &models.LevelUpRequest{
+		Class:                 "Rogue",
+		AbilityScoreIncreases: []models.LevelUpIncrease{{Ability: "dex", Amount: 1}, {Ability: "con", Amount: 1}},
+	}, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, 16, character.AbilityScores.Dexterity.Score)
+	assert.Equal(t, 15, character.AbilityScores.Constitution.Score)
+	assert.Contains(t, character.AbilityScores.Increases, models.AbilityScoreIncrease{Ability: "dexterity", Amount: 1, Source: rules.IncreaseLevelUp, Level: 4})
+}
+
+func TestEngine_LevelUp_FeatInsteadOfIncrease(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testCharacter("Fighter", 3)
+	character.Subclass = "Champion"
+
+	result, err := engine.LevelUp(character, &models.LevelUpRequest{Class: "Fighter", Feat: "grappler"}, nil)
+	require.NoError(t, err)
+
+	require.NotEmpty(t, result.Features)
+	assert.Equal(t, "Grappler", result.Features[0].Name)
+	assert.Equal(t, "Feat", result.Features[0].Source)
+	assert.Equal(t, 13, character.AbilityScores.Strength.Score)
+}
+
+func TestEngine_LevelUp_SpellPicks(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testCharacter("Wizard", 1)
+	character.Spellcasting = &models.Spellcasting{
+		CantripsKnown: []string{"Fire Bolt", "Light", "Mage Hand"},
+		SpellsKnown:   []string{"Magic Missile", "Shield", "Sleep", "Mage Armor", "Burning Hands", "Detect Magic"},
+	}
+
+	_, err := engine.LevelUp(character, &models.LevelUpRequest{Class: "Wizard", Subclass: "School of Evocation", Spells: []string{"Shield", "Cure Wounds"}}, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `spells[0] "Shield" is already known`)
+	assert.Contains(t, err.Error(), `spells[1] "Cure Wounds" is not on the spell list for Wizard`)
+
+	_, err = engine.LevelUp(character, &models.LevelUpRequest{Class: "Wizard", Subclass: "School of Evocation", Spells: []string{"Fireball", "Thunderwave"}}, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `spells[0] "Fireball" is not a spell of level 1 to 1`)
+
+	_, err = engine.LevelUp(character, &models.LevelUpRequest{Class: "Wizard", Subclass: "School of Evocation", Spells: []string{"Thunderwave", "Fog Cloud"}}, nil)
+	require.NoError(t, err)
+	assert.Contains(t, character.Spellcasting.SpellsKnown, "Thunderwave")
+	assert.Len(t, character.Spellcasting.SpellsKnown, 8)
+}
+
+func TestEngine_LevelUp_Multiclass(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testCharacter("Fighter", 3)
+	character.Subclass = "Champion"
+	character.AbilityScores.Charisma.Score = 14
+
+	_, err := engine.LevelUp(character, &models.LevelUpRequest{Class: "Warlock", Subclass: "The Fiend", Cantrips: []string{"Eldritch Blast", "Minor Illusion"}, Spells: []string{"Hellish Rebuke", "Charm Person"}}, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, character.Level)
+	assert.Equal(t, 3, character.ClassLevel)
+	require.Len(t, character.Multiclass, 1)
+	assert.Equal(t, models.MulticlassEntry{Class: "Warlock", Subclass: "The Fiend", Level: 1}, character.Multiclass[0])
+	require.NotNil(t, character.Spellcasting.PactMagic)
+	assert.Equal(t, 1, character.Spellcasting.PactMagic.Total)
+}
+
+func TestMaxSpellLevel(t *testing.T) {
+	assert.Equal(t, 1, rules.MaxSpellLevel("full", 1))
+	assert.Equal(t, 3, rules.MaxSpellLevel("full", 5))
+	assert.Equal(t, 9, rules.MaxSpellLevel("full", 17))
+	assert.Equal(t, 0, rules.MaxSpellLevel("half", 1))
+	assert.Equal(t, 1, rules.MaxSpellLevel("half", 2))
+	assert.Equal(t, 5, rules.MaxSpellLevel("pact", 9))
+	assert.Equal(t, 0, rules.MaxSpellLevel("", 9))
+}
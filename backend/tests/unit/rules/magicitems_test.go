@@ -0,0 +1,250 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
+)
+
+func TestEngine_Apply_MagicItemModifiersNeedAttunement(t *testing.T) {
+	tests := []struct {
+		name     string
+		equipped bool
+		attuned  bool
+		ac       int
+		save     int
+	}{
+		{"carried", false, false, 11, 1},
+		{"equipped without attunement", true, false, 11, 1},
+		{"equipped and attuned", true, true, 12, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := newEngine(t)
+			ring := ringOfProtection("ring")
+			ring.Equipped = tt.equipped
+			ring.Attuned = tt.attuned
+			character := testFighter(t, 1, ring)
+
+			engine.Apply(character)
+
+			assert.Equal(t, tt.ac, character.ArmorClass)
+			assert.Equal(t, tt.save, character.SavingThrowBonuses.Strength)
+			assert.Equal(t, tt.save, character.SavingThrowBonuses.Wisdom)
+		})
+	}
+}
+
+func TestEngine_Apply_MagicItemArmorClassBreakdown(t *testing.T) {
+	engine := newEngine(t)
+	ring := ringOfProtection("ring")
+	ring.Equipped = true
+	ring.Attuned = true
+	character := testFighter(t, 1, ring)
+
+	engine.Apply(character)
+	engine.Apply(character)
+
+	assert.Equal(t, 12, character.ArmorClass, "applying twice doesn't stack the bonus")
+	assert.Equal(t, models.ArmorClassComponent{Source: "Ring of Protection", Value: 1}, character.ArmorClassBreakdown[len(character.ArmorClassBreakdown)-1])
+}
+
+func TestEngine_Apply_MagicItemSetsAbilityScore(t *testing.T) {
+	tests := []struct {
+		name     string
+		base     int
+		expected int
+	}{
+		{"raises a lower score", 13, 19},
+		{"keeps a higher score", 20, 20},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := newEngine(t)
+			gauntlets := magicItem("Gauntlets of Ogre Power", "gear", models.MagicItem{
+				Rarity:             "uncommon",
+				RequiresAttunement: true,
+				Modifiers:          []models.ItemModifier{{Target: "strength", Operation: rules.ModifierSet, Value: 19}},
+			})
+			gauntlets.Equipped = true
+			gauntlets.Attuned = true
+			character := testFighter(t, 1, gauntlets)
+			character.AbilityScores = models.AbilityScores{
+				Strength:     models.AbilityScore{Base: tt.base},
+				Dexterity:    models.AbilityScore{Base: 10},
+				Constitution: models.AbilityScore{Base: 10},
+				Intelligence: models.AbilityScore{Base: 10},
+				Wisdom:       models.AbilityScore{Base: 10},
+				Charisma:     models.AbilityScore{Base: 10},
+			}
+
+			engine.Apply(character)
+
+			assert.Equal(t, tt.expected, character.AbilityScores.Strength.Score)
+			assert.Equal(t, rules.AbilityModifier(tt.expected), character.AbilityScores.Strength.Modifier)
+		})
+	}
+}
+
+func TestSkippedAbilityModifiers(t *testing.T) {
+	engine := newEngine(t)
+	gauntlets := magicItem("Gauntlets of Ogre Power", "gear", models.MagicItem{
+		Rarity:    "uncommon",
+		Modifiers: []models.ItemModifier{{Target: "str", Operation: rules.ModifierSet, Value: 19}},
+	})
+	gauntlets.Equipped = true
+
+	// Stored scores are left alone so taking the gauntlets off restores them
+	character := testFighter(t, 1, gauntlets)
+	engine.Apply(character)
+	assert.Equal(t, 13, character.AbilityScores.Strength.Score)
+	assert.Equal(t, []string{"Gauntlets of Ogre Power does not change strength without base ability scores"},
+		rules.SkippedAbilityModifiers(nil, character))
+
+	character.AbilityScores = baseScores(13, 13, 13, 13, 13, 13)
+	engine.Apply(character)
+	assert.Equal(t, 19, character.AbilityScores.Strength.Score)
+	assert.Empty(t, rules.SkippedAbilityModifiers(nil, character))
+}
+
+func TestEngine_Apply_MagicWeapon(t *testing.T) {
+	engine := newEngine(t)
+	sword := models.InventoryItem{
+		ID:       "a",
+		ItemID:   "flame-tongue",
+		Quantity: 1,
+		Equipped: true,
+		Item: &models.Item{
+			ID: "flame-tongue", Name: "Sword +1", Source: rules.ItemSourceHomebrew, Category: "weapon",
+			WeaponCategory: "martial melee", Damage: "1d8", DamageType: "slashing",
+			Magic: &models.MagicItem{Rarity: "uncommon", Modifiers: []models.ItemModifier{
+				{Target: rules.ModifierAttack, Value: 1},
+				{Target: rules.ModifierDamage, Value: 1},
+			}},
+		},
+	}
+	character := testFighter(t, 1, sword)
+
+	engine.Apply(character)
+
+	require.Len(t, character.Attacks, 1)
+	// +1 Strength, +2 proficiency, +1 magic
+	assert.Equal(t, 4, character.Attacks[0].AttackBonus)
+	assert.Equal(t, "1d8+2", character.Attacks[0].Damage)
+}
+
+func TestEngine_Apply_MagicItemSpellAttack(t *testing.T) {
+	engine := newEngine(t)
+	character := testWizard(t)
+	character.Spellcasting.SpellcastingAbility = "intelligence"
+	wand := magicItem("Wand of the War Mage", "gear", models.MagicItem{
+		Rarity:             "uncommon",
+		RequiresAttunement: true,
+		Prerequisites:      &models.AttunementPrerequisites{Spellcaster: true},
+		Modifiers:          []models.ItemModifier{{Target: rules.ModifierSpellAttack, Value: 2}},
+	})
+	character.Inventory = &models.Inventory{Items: []models.InventoryItem{wand}}
+
+	require.NoError(t, engine.Equip(character, wand.ID, &models.EquipRequest{Attune: true}))
+
+	assert.Equal(t, 5, character.Spellcasting.SpellAttackBonus)
+	assert.Equal(t, 11, character.Spellcasting.SpellSaveDC)
+}
+
+func TestEngine_Equip_AttunementPrerequisites(t *testing.T) {
+	tests := []struct {
+		name          string
+		prerequisites models.AttunementPrerequisites
+		expected      string
+	}{
+		{"class", models.AttunementPrerequisites{Classes: []string{"Wizard", "Sorcerer"}}, "Staff requires attunement by a Wizard or Sorcerer"},
+		{"race", models.AttunementPrerequisites{Races: []string{"Dwarf"}}, "Staff requires attunement by a Dwarf"},
+		{"spellcaster", models.AttunementPrerequisites{Spellcaster: true}, "Staff requires attunement by a spellcaster"},
+		{"met", models.AttunementPrerequisites{Classes: []string{"fighter"}, Races: []string{"human"}}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := newEngine(t)
+			staff := magicItem("Staff", "gear", models.MagicItem{Rarity: "rare", RequiresAttunement: true, Prerequisites: &tt.prerequisites})
+			character := testFighter(t, 1, staff)
+
+			err := engine.Equip(character, staff.ID, &models.EquipRequest{Attune: true})
+
+			if tt.expected == "" {
+				require.NoError(t, err)
+				assert.True(t, character.Inventory.Items[0].Attuned)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.expected, err.Error())
+			assert.False(t, character.Inventory.Items[0].Attuned)
+		})
+	}
+}
+
+func TestEngine_SpendCharges(t *testing.T) {
+	engine := newEngine(t)
+	character := testFighter(t, 1, wandOfMagicMissiles(rules.RechargeDawn, "1d6+1"))
+
+	engine.Apply(character)
+	require.NotNil(t, character.Inventory.Items[0].Charges)
+	assert.Equal(t, 7, *character.Inventory.Items[0].Charges, "new items start with every charge")
+
+	require.NoError(t, engine.SpendCharges(character, "Wand of Magic Missiles", 3))
+	assert.Equal(t, 4, *character.Inventory.Items[0].Charges)
+
+	err := engine.SpendCharges(character, "Wand of Magic Missiles", 5)
+	require.Error(t, err)
+	assert.Equal(t, "only 4 charges of Wand of Magic Missiles remain", err.Error())
+}
+
+func TestEngine_SpendCharges_NeedsAttunement(t *testing.T) {
+	engine := newEngine(t)
+	staff := magicItem("Staff of Healing", "gear", models.MagicItem{
+		Rarity:             "rare",
+		RequiresAttunement: true,
+		Charges:            &models.ItemCharges{Maximum: 10, Recharge: rules.RechargeDawn},
+	})
+	character := testFighter(t, 1, staff)
+
+	err := engine.SpendCharges(character, staff.ID, 1)
+
+	require.Error(t, err)
+	assert.Equal(t, "Staff of Healing must be attuned to use its charges", err.Error())
+}
+
+func TestEngine_Dawn_RegainsRolledCharges(t *testing.T) {
+	engine := newEngine(t)
+	wand := wandOfMagicMissiles(rules.RechargeDawn, "1d6+1")
+	spent := 1
+	wand.Charges = &spent
+	character := testFighter(t, 1, wand)
+
+	result := engine.Dawn(character, rollsOf(3))
+
+	assert.Equal(t, []string{"Wand of Magic Missiles"}, result.ItemsRecharged)
+	assert.Equal(t, 5, *character.Inventory.Items[0].Charges)
+}
+
+func TestEngine_Rests_RechargeItems(t *testing.T) {
+	engine := newEngine(t)
+	empty := 0
+	wand := wandOfMagicMissiles(rules.RechargeLongRest, "")
+	wand.Charges = &empty
+	character := testFighter(t, 1, wand)
+
+	short, err := engine.ShortRest(character, &models.ShortRestRequest{}, nil)
+	require.NoError(t, err)
+	assert.Empty(t, short.ItemsRecharged)
+
+	long, err := engine.LongRest(character)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Wand of Magic Missiles"}, long.ItemsRecharged)
+	assert.Equal(t, 7, *character.Inventory.Items[0].Charges)
+}
@@ -0,0 +1,127 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
+)
+
+func TestEngine_Apply_Resources(t *testing.T) {
+	tests := []struct {
+		name     string
+		class    string
+		level    int
+		expected []models.Resource
+	}{
+		{"rage", "Barbarian", 3, []models.Resource{
+			{Name: "Rage", Source: "Barbarian", Maximum: 3, Current: 3, Recharge: rules.RechargeLongRest},
+		}},
+		{"unlimited rage", "Barbarian", 20, []models.Resource{
+			{Name: "Rage", Source: "Barbarian", Maximum: 6, Current: 6, Recharge: rules.RechargeLongRest, Unlimited: true},
+		}},
+		{"bardic inspiration uses charisma", "Bard", 1, []models.Resource{
+			{Name: "Bardic Inspiration", Source: "Bard", Maximum: 1, Current: 1, Recharge: rules.RechargeLongRest},
+		}},
+		{"font of inspiration", "Bard", 5, []models.Resource{
+			{Name: "Bardic Inspiration", Source: "Bard", Maximum: 1, Current: 1, Recharge: rules.RechargeShortRest},
+		}},
+		{"ki per level", "Monk", 5, []models.Resource{
+			{Name: "Ki", Source: "Monk", Maximum: 5, Current: 5, Recharge: rules.RechargeShortRest},
+		}},
+		{"no ki at level 1", "Monk", 1, nil},
+		{"fighter", "Fighter", 17, []models.Resource{
+			{Name: "Second Wind", Source: "Fighter", Maximum: 1, Current: 1, Recharge: rules.RechargeShortRest},
+			{Name: "Action Surge", Source: "Fighter", Maximum: 2, Current: 2, Recharge: rules.RechargeShortRest},
+			{Name: "Indomitable", Source: "Fighter", Maximum: 3, Current: 3, Recharge: rules.RechargeLongRest},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := newEngine(t)
+			character := testCharacter(tt.class, tt.level)
+			engine.Apply(character)
+
+			assert.Equal(t, tt.expected, character.Resources)
+		})
+	}
+}
+
+func TestEngine_Apply_ResourcesMulticlass(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testCharacter("Cleric", 8, models.MulticlassEntry{Class: "Paladin", Level: 3})
+	character.ClassLevel = 5
+	character.Resources = []models.Resource{
+		{Name: "channel divinity", Used: 1},
+		{Name: "Luck Points", Source: "Lucky", Maximum: 3, Used: 1, Recharge: rules.RechargeLongRest},
+		{Name: "Sorcery Points", Source: "Sorcerer", Maximum: 5},
+	}
+	engine.Apply(character)
+
+	names := make([]string, len(character.Resources))
+	for i, resource := range character.Resources {
+		names[i] = resource.Name
+	}
+	assert.Equal(t, []string{"Channel Divinity", "Divine Sense", "Lay on Hands", "Luck Points"}, names)
+
+	// Channel Divinity from both classes is one pool with the larger maximum
+	channel := character.Resources[0]
+	assert.Equal(t, 1, channel.Maximum)
+	assert.Equal(t, 1, channel.Used)
+	assert.Equal(t, 0, channel.Current)
+
+	assert.Equal(t, 15, character.Resources[2].Maximum)
+	assert.Equal(t, 2, character.Resources[3].Current)
+}
+
+func TestEngine_SpendAndRestoreResource(t *testing.T) {
+	engine := newEngine(t)
+	character := testCharacter("Monk", 5)
+
+	require.NoError(t, engine.SpendResource(character, "ki", 0))
+	require.NoError(t, engine.SpendResource(character, "Ki", 2))
+	assert.Equal(t, 2, character.Resources[0].Current)
+
+	assert.EqualError(t, engine.SpendResource(character, "Ki", 3), "only 2 uses of Ki remain")
+	assert.EqualError(t, engine.SpendResource(character, "Rage", 1), `character has no resource "Rage"`)
+
+	require.NoError(t, engine.RestoreResource(character, "Ki", 1))
+	assert.Equal(t, 3, character.Resources[0].Current)
+	require.NoError(t, engine.RestoreResource(character, "Ki", 0))
+	assert.Equal(t, 5, character.Resources[0].Current)
+}
+
+func TestEngine_SpendResource_Unlimited(t *testing.T) {
+	engine := newEngine(t)
+	character := testCharacter("Barbarian", 20)
+
+	for range 10 {
+		require.NoError(t, engine.SpendResource(character, "Rage", 1))
+	}
+	assert.Equal(t, 0, character.Resources[0].Used)
+}
+
+func TestEngine_Rests_RestoreResources(t *testing.T) {
+	engine := newEngine(t)
+	character := testCharacter("Fighter", 9)
+	engine.Apply(character)
+	character.HitPoints.Current = 10
+
+	for _, name := range []string{"Second Wind", "Action Surge", "Indomitable"} {
+		require.NoError(t, engine.SpendResource(character, name, 1))
+	}
+
+	result, err := engine.ShortRest(character, &models.ShortRestRequest{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Second Wind", "Action Surge"}, result.ResourcesRestored)
+	assert.Equal(t, 0, character.Resources[2].Current)
+
+	result, err = engine.LongRest(character)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Indomitable"}, result.ResourcesRestored)
+	assert.Equal(t, 1, character.Resources[2].Current)
+}
@@ -0,0 +1,123 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
+)
+
+func TestEngine_Apply_HitDice(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testCharacter("Fighter", 5, models.MulticlassEntry{Class: "Wizard", Level: 2})
+	character.ClassLevel = 3
+	character.HitDice = []models.HitDice{{Class: "fighter", Spent: 5}}
+	engine.Apply(character)
+
+	assert.Equal(t, []models.HitDice{
+		{Class: "Fighter", Die: 10, Total: 3, Spent: 3},
+		{Class: "Wizard", Die: 6, Total: 2, Spent: 0},
+	}, character.HitDice)
+}
+
+func TestEngine_ShortRest(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testCharacter("Warlock", 4)
+	engine.Apply(character)
+	character.HitPoints.Current = 10
+	character.Spellcasting.PactMagic.Used = 2
+
+	result, err := engine.ShortRest(character, &models.ShortRestRequest{
+		HitDice: []models.HitDiceSpend{{Class: "Warlock", Count: 2}},
+	}, func(die int) int {
+		assert.Equal(t, 8, die)
+		return 6
+	})
+	require.NoError(t, err)
+
+	// Two rolls of 6 plus Con +1
+	assert.Equal(t, 14, result.HitPointsRegained)
+	assert.Equal(t, 24, character.HitPoints.Current)
+	assert.Equal(t, []models.HitDieRoll{
+		{Class: "Warlock", Die: 8, Roll: 6, Healing: 7},
+		{Class: "Warlock", Die: 8, Roll: 6, Healing: 7},
+	}, result.Rolls)
+	assert.Equal(t, 2, character.HitDice[0].Spent)
+	assert.Equal(t, 2, result.SpellSlotsRestored)
+	assert.Equal(t, 0, character.Spellcasting.PactMagic.Used)
+
+	// Healing stops at the hit point maximum
+	result, err = engine.ShortRest(character, &models.ShortRestRequest{
+		HitDice: []models.HitDiceSpend{{Class: "Warlock", Count: 1}},
+		Method:  rules.HitDieAverage,
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.HitPointsRegained)
+	assert.Equal(t, character.HitPoints.Maximum, character.HitPoints.Current)
+	assert.Equal(t, 5, result.Rolls[0].Roll)
+}
+
+func TestEngine_ShortRest_Rejections(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testCharacter("Fighter", 2)
+	character.HitDice = []models.HitDice{{Class: "Fighter", Spent: 1}}
+	character.HitPoints.Current = 5
+
+	_, err := engine.ShortRest(character, &models.ShortRestRequest{
+		HitDice: []models.HitDiceSpend{{Class: "Fighter", Count: 2}},
+	}, nil)
+	assert.EqualError(t, err, "hitDice[0] only 1 Fighter hit dice remain")
+
+	_, err = engine.ShortRest(character, &models.ShortRestRequest{
+		HitDice: []models.HitDiceSpend{{Class: "Wizard", Count: 1}},
+	}, nil)
+	assert.EqualError(t, err, `hitDice[0] "Wizard" is not one of the character's classes`)
+
+	character.HitPoints.Current = 0
+	_, err = engine.ShortRest(character, &models.ShortRestRequest{
+		HitDice: []models.HitDiceSpend{{Class: "Fighter", Count: 1}},
+	}, nil)
+	assert.EqualError(t, err, "a character must have at least 1 hit point to benefit from a short rest")
+
+	character.DeathSaves = &models.DeathSaves{Failures: 3, Dead: true}
+	_, err = engine.ShortRest(character, &models.ShortRestRequest{}, nil)
+	assert.EqualError(t, err, "character is dead")
+	assert.Equal(t, 1, character.HitDice[0].Spent)
+}
+
+func TestEngine_LongRest(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testCharacter("Fighter", 5, models.MulticlassEntry{Class: "Wizard", Level: 2})
+	character.ClassLevel = 5
+	character.Level = 7
+	character.HitDice = []models.HitDice{{Class: "Fighter", Spent: 4}, {Class: "Wizard", Spent: 2}}
+	character.HitPoints = models.HitPoints{Current: 5, Temporary: 4}
+	character.DeathSaves = &models.DeathSaves{Successes: 1, Failures: 2}
+	engine.Apply(character)
+	character.Spellcasting.SpellSlots.Level1.Used = 2
+
+	result, err := engine.LongRest(character)
+	require.NoError(t, err)
+
+	assert.Equal(t, character.HitPoints.Maximum, character.HitPoints.Current)
+	assert.Equal(t, character.HitPoints.Maximum-5, result.HitPointsRegained)
+	assert.Equal(t, 0, character.HitPoints.Temporary)
+	assert.Equal(t, models.DeathSaves{}, *character.DeathSaves)
+	assert.Equal(t, 2, result.SpellSlotsRestored)
+	assert.Equal(t, 0, character.Spellcasting.SpellSlots.Level1.Used)
+
+	// Half of seven dice rounds down to three, largest dice first
+	assert.Equal(t, 3, result.HitDiceRecovered)
+	assert.Equal(t, 1, character.HitDice[0].Spent)
+	assert.Equal(t, 2, character.HitDice[1].Spent)
+
+	character.HitPoints.Current = 0
+	_, err = engine.LongRest(character)
+	assert.EqualError(t, err, "a character must have at least 1 hit point to benefit from a long rest")
+}
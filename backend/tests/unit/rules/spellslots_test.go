@@ -0,0 +1,115 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
+)
+
+func slotTotals(slots *models.SpellSlots) []int {
+	var totals []int
+	for _, slot := range rules.SpellSlotLevels(slots) {
+		totals = append(totals, slot.Total)
+	}
+	return totals
+}
+
+func TestCasterLevel(t *testing.T) {
+	tests := []struct {
+		name         string
+		levels       []rules.ClassLevel
+		progressions []string
+		expected     int
+	}{
+		{"single full caster", []rules.ClassLevel{{Level: 5}}, []string{"full"}, 5},
+		{"single paladin level 1", []rules.ClassLevel{{Level: 1}}, []string{"half"}, 0},
+		{"single paladin level 5", []rules.ClassLevel{{Level: 5}}, []string{"half"}, 3},
+		{"single third caster level 7", []rules.ClassLevel{{Level: 7}}, []string{"third"}, 3},
+		{"paladin and sorcerer", []rules.ClassLevel{{Level: 5}, {Level: 2}}, []string{"half", "full"}, 4},
+		{"ranger and third caster", []rules.ClassLevel{{Level: 3}, {Level: 5}}, []string{"half", "third"}, 2},
+		{"warlock does not count", []rules.ClassLevel{{Level: 3}, {Level: 5}}, []string{"full", "pact"}, 3},
+		{"non-caster", []rules.ClassLevel{{Level: 10}}, []string{""}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, rules.CasterLevel(tt.levels, tt.progressions))
+		})
+	}
+}
+
+func TestPactMagicSlots(t *testing.T) {
+	tests := []struct {
+		level     int
+		slots     int
+		slotLevel int
+	}{
+		{1, 1, 1},
+		{2, 2, 1},
+		{3, 2, 2},
+		{5, 2, 3},
+		{9, 2, 5},
+		{11, 3, 5},
+		{17, 4, 5},
+	}
+
+	for _, tt := range tests {
+		slots, slotLevel := rules.PactMagicSlots(tt.level)
+		assert.Equal(t, tt.slots, slots, "warlock level %d", tt.level)
+		assert.Equal(t, tt.slotLevel, slotLevel, "warlock level %d", tt.level)
+	}
+}
+
+func TestEngine_Apply_MulticlassSpellSlots(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testCharacter("Wizard", 8, models.MulticlassEntry{Class: "Cleric", Level: 3})
+	character.Spellcasting = &models.Spellcasting{
+		SpellSlots: &models.SpellSlots{Level1: models.SpellSlotLevel{Total: 9, Used: 6}},
+	}
+
+	engine.Apply(character)
+
+	assert.Equal(t, 5, character.ClassLevel)
+	assert.Equal(t, []int{4, 3, 3, 2, 0, 0, 0, 0, 0}, slotTotals(character.Spellcasting.SpellSlots))
+	assert.Equal(t, 4, character.Spellcasting.SpellSlots.Level1.Used, "used slots are clamped to the new total")
+	assert.Nil(t, character.Spellcasting.PactMagic)
+}
+
+func TestEngine_Apply_PactMagicTrackedSeparately(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testCharacter("Warlock", 8, models.MulticlassEntry{Class: "Sorcerer", Level: 3})
+
+	engine.Apply(character)
+
+	require.NotNil(t, character.Spellcasting)
+	assert.Equal(t, "charisma", character.Spellcasting.SpellcastingAbility)
+	assert.Equal(t, models.PactMagic{SlotLevel: 3, Total: 2}, *character.Spellcasting.PactMagic)
+	assert.Equal(t, []int{4, 2, 0, 0, 0, 0, 0, 0, 0}, slotTotals(character.Spellcasting.SpellSlots))
+}
+
+func TestEngine_Apply_NonCasterHasNoSlots(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testCharacter("Fighter", 5)
+	character.Spellcasting = &models.Spellcasting{SpellSlots: &models.SpellSlots{Level1: models.SpellSlotLevel{Total: 2}}}
+
+	engine.Apply(character)
+
+	assert.Nil(t, character.Spellcasting.SpellSlots)
+}
+
+func TestEngine_Apply_HomebrewClassKeepsSlots(t *testing.T) {
+	engine := newEngine(t)
+
+	character := testCharacter("Blood Hunter", 5)
+	character.Spellcasting = &models.Spellcasting{SpellSlots: &models.SpellSlots{Level1: models.SpellSlotLevel{Total: 2}}}
+
+	engine.Apply(character)
+
+	assert.Equal(t, 2, character.Spellcasting.SpellSlots.Level1.Total)
+}
@@ -0,0 +1,186 @@
+package rules_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
+)
+
+func TestEngine_Transfer_Items(t *testing.T) {
+	engine := newEngine(t)
+	giver, receiver := testParty(t,
+		models.InventoryItem{ID: "darts", ItemID: "dart", Quantity: 20},
+		models.InventoryItem{ID: "sword", ItemID: "longsword", Quantity: 1, Equipped: true},
+	)
+	receiver.Inventory.Items = []models.InventoryItem{{ID: "pouch", ItemID: "dart", Quantity: 5}}
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	transfer, err := engine.Transfer(giver, receiver, &models.TransferRequest{
+		Items: []models.TransferItemRequest{{ID: "darts", Quantity: 8}, {ID: "sword"}},
+		Memo:  "For the road",
+	}, at)
+
+	require.NoError(t, err)
+	require.Len(t, giver.Inventory.Items, 1, "the sword was given in full")
+	assert.Equal(t, 12, giver.Inventory.Items[0].Quantity)
+	assert.Empty(t, giver.Attacks, "the given sword no longer makes an attack")
+
+	require.Len(t, receiver.Inventory.Items, 2)
+	assert.Equal(t, 13, receiver.Inventory.Items[0].Quantity, "darts stack onto the receiver's own")
+	sword := receiver.Inventory.Items[1]
+	assert.Equal(t, "longsword", sword.ItemID)
+	assert.False(t, sword.Equipped)
+	assert.NotEqual(t, "sword", sword.ID)
+
+	assert.Equal(t, []models.TransferredItem{
+		{ID: "pouch", ItemID: "dart", Name: "Dart", Quantity: 8},
+		{ID: sword.ID, ItemID: "longsword", Name: "Longsword", Quantity: 1},
+	}, transfer.Items)
+
+	require.Len(t, giver.Transfers, 1)
+	require.Len(t, receiver.Transfers, 1)
+	assert.Equal(t, transfer, giver.Transfers[0])
+	assert.Equal(t, rules.TransferSent, giver.Transfers[0].Direction)
+	assert.Equal(t, "receiver", giver.Transfers[0].CharacterID)
+	assert.Equal(t, rules.TransferReceived, receiver.Transfers[0].Direction)
+	assert.Equal(t, "Giver", receiver.Transfers[0].CharacterName)
+	assert.Equal(t, transfer.ID, receiver.Transfers[0].ID)
+	assert.Equal(t, at, receiver.Transfers[0].TransferredAt)
+}
+
+func TestEngine_Transfer_Currency(t *testing.T) {
+	engine := newEngine(t)
+	giver, receiver := testParty(t)
+	giver.Inventory.Currency = &models.Currency{Platinum: 1}
+
+	_, err := engine.Transfer(giver, receiver, &models.TransferRequest{
+		Currency: &models.TransferCurrency{Amount: 3, Denomination: "gp"},
+		Memo:     "Share of the loot",
+	}, time.Now())
+
+	require.NoError(t, err)
+	assert.Equal(t, models.Currency{Gold: 7}, *giver.Inventory.Currency)
+	assert.Equal(t, models.Currency{Gold: 3}, *receiver.Inventory.Currency)
+	require.Len(t, giver.CurrencyLedger, 1)
+	assert.Equal(t, "debit", giver.CurrencyLedger[0].Type)
+	assert.Equal(t, "Transfer to Receiver: Share of the loot", giver.CurrencyLedger[0].Memo)
+	require.Len(t, receiver.CurrencyLedger, 1)
+	assert.Equal(t, "credit", receiver.CurrencyLedger[0].Type)
+	assert.Equal(t, "Transfer from Giver: Share of the loot", receiver.CurrencyLedger[0].Memo)
+}
+
+func TestEngine_Transfer_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  models.TransferRequest
+		expected string
+	}{
+		{"nothing to move", models.TransferRequest{}, "a transfer must move at least one item or some currency"},
+		{"unknown item", models.TransferRequest{Items: []models.TransferItemRequest{{ID: "z"}}}, `Giver has no item "z"`},
+		{"too many", models.TransferRequest{Items: []models.TransferItemRequest{{ID: "darts", Quantity: 21}}}, "only 20 of Dart to give"},
+		{"insufficient funds", models.TransferRequest{Currency: &models.TransferCurrency{Amount: 1, Denomination: "pp"}}, "insufficient funds"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := newEngine(t)
+			giver, receiver := testParty(t, models.InventoryItem{ID: "darts", ItemID: "dart", Quantity: 20})
+
+			_, err := engine.Transfer(giver, receiver, &tt.request, time.Now())
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expected)
+			assert.Empty(t, receiver.Transfers)
+		})
+	}
+}
+
+func TestEngine_Transfer_EndsAttunement(t *testing.T) {
+	engine := newEngine(t)
+	ring := ringOfProtection("ring")
+	ring.Equipped = true
+	ring.Attuned = true
+	giver, receiver := testParty(t, ring)
+
+	_, err := engine.Transfer(giver, receiver, &models.TransferRequest{
+		Items: []models.TransferItemRequest{{ID: ring.ID}},
+	}, time.Now())
+
+	require.NoError(t, err)
+	require.Len(t, receiver.Inventory.Items, 1)
+	assert.False(t, receiver.Inventory.Items[0].Attuned)
+	assert.False(t, receiver.Inventory.Items[0].Equipped)
+	assert.Equal(t, 11, giver.ArmorClass, "the giver loses the ring's bonus")
+}
+
+func TestEngine_Transfer_ContainerContents(t *testing.T) {
+	engine := newEngine(t)
+	giver, receiver := testParty(t,
+		models.InventoryItem{ID: "pack", ItemID: "backpack", Quantity: 1},
+		models.InventoryItem{ID: "pouch", ItemID: "pouch", Quantity: 1, ContainerID: "pack"},
+		models.InventoryItem{ID: "tinderbox", ItemID: "tinderbox", Quantity: 1, ContainerID: "pouch"},
+		models.InventoryItem{ID: "torch", ItemID: "torch", Quantity: 2, ContainerID: "pack"},
+		models.InventoryItem{ID: "crowbar", ItemID: "crowbar", Quantity: 1},
+	)
+
+	transfer, err := engine.Transfer(giver, receiver, &models.TransferRequest{
+		Items: []models.TransferItemRequest{{ID: "pack"}},
+	}, time.Now())
+
+	require.NoError(t, err)
+	require.Len(t, giver.Inventory.Items, 1)
+	assert.Equal(t, "crowbar", giver.Inventory.Items[0].ID)
+	require.Len(t, receiver.Inventory.Items, 4)
+	require.Len(t, transfer.Items, 4)
+
+	byItemID := map[string]models.InventoryItem{}
+	for _, item := range receiver.Inventory.Items {
+		byItemID[item.ItemID] = item
+	}
+	pack := byItemID["backpack"]
+	assert.Empty(t, pack.ContainerID)
+	assert.Equal(t, pack.ID, byItemID["pouch"].ContainerID)
+	assert.Equal(t, pack.ID, byItemID["torch"].ContainerID)
+	assert.Equal(t, byItemID["pouch"].ID, byItemID["tinderbox"].ContainerID)
+	assert.Equal(t, 4.0, pack.ContentsWeight)
+}
+
+func TestEngine_Transfer_SkipsContentsAlreadyMoved(t *testing.T) {
+	engine := newEngine(t)
+	giver, receiver := testParty(t,
+		models.InventoryItem{ID: "pack", ItemID: "backpack", Quantity: 1},
+		models.InventoryItem{ID: "pouch", ItemID: "pouch", Quantity: 1, ContainerID: "pack"},
+		models.InventoryItem{ID: "tinderbox", ItemID: "tinderbox", Quantity: 1, ContainerID: "pouch"},
+	)
+
+	transfer, err := engine.Transfer(giver, receiver, &models.TransferRequest{
+		Items: []models.TransferItemRequest{{ID: "pack"}, {ID: "tinderbox"}},
+	}, time.Now())
+
+	require.NoError(t, err)
+	assert.Empty(t, giver.Inventory.Items)
+	assert.Len(t, receiver.Inventory.Items, 3)
+	assert.Len(t, transfer.Items, 3)
+}
+
+func TestEngine_Transfer_PackedItemArrivesUnpacked(t *testing.T) {
+	engine := newEngine(t)
+	giver, receiver := testParty(t,
+		models.InventoryItem{ID: "pack", ItemID: "backpack", Quantity: 1},
+		models.InventoryItem{ID: "torches", ItemID: "torch", Quantity: 5, ContainerID: "pack"},
+	)
+	receiver.Inventory.Items = []models.InventoryItem{{ID: "own", ItemID: "torch", Quantity: 1}}
+
+	_, err := engine.Transfer(giver, receiver, &models.TransferRequest{
+		Items: []models.TransferItemRequest{{ID: "torches", Quantity: 2}},
+	}, time.Now())
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, giver.Inventory.Items[1].Quantity)
+	require.Len(t, receiver.Inventory.Items, 1)
+	assert.Equal(t, 3, receiver.Inventory.Items[0].Quantity)
+}
@@ -0,0 +1,88 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/dice"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
+	"github.com/yourusername/dnd-character-creator/internal/service"
+)
+
+func abilityScoresFrom(values []int) models.AbilityScores {
+	return models.AbilityScores{
+		Strength:     models.AbilityScore{Score: values[0]},
+		Dexterity:    models.AbilityScore{Score: values[1]},
+		Constitution: models.AbilityScore{Score: values[2]},
+		Intelligence: models.AbilityScore{Score: values[3]},
+		Wisdom:       models.AbilityScore{Score: values[4]},
+		Charisma:     models.AbilityScore{Score: values[5]},
+	}
+}
+
+func TestAbilityScoreService_GenerateRolled(t *testing.T) {
+	svc := service.NewAbilityScoreService("test-secret")
+
+	generation, err := svc.Generate(rules.MethodRolled)
+	require.NoError(t, err)
+	require.NotEmpty(t, generation.Seed)
+	require.Len(t, generation.Values, 6)
+
+	seed, err := dice.ParseSeed(generation.Seed)
+	require.NoError(t, err)
+	assert.Equal(t, rules.RollAbilityScores(seed), generation.Rolls)
+
+	result := svc.Validate(&models.AbilityScoreValidationRequest{
+		Method:        rules.MethodRolled,
+		Seed:          generation.Seed,
+		AbilityScores: abilityScoresFrom(generation.Values),
+	})
+	assert.True(t, result.Valid)
+
+	result = svc.Validate(&models.AbilityScoreValidationRequest{
+		Method:        rules.MethodRolled,
+		Seed:          dice.FormatSeed(seed),
+		AbilityScores: abilityScoresFrom(generation.Values),
+	})
+	assert.False(t, result.Valid)
+	assert.Contains(t, result.Errors[0], "was not issued by this server")
+}
+
+func TestAbilityScoreService_GenerateFixedMethods(t *testing.T) {
+	svc := service.NewAbilityScoreService("test-secret")
+
+	generation, err := svc.Generate(rules.MethodStandardArray)
+	require.NoError(t, err)
+	assert.Equal(t, []int{15, 14, 13, 12, 10, 8}, generation.Values)
+	assert.Empty(t, generation.Seed)
+
+	generation, err = svc.Generate(rules.MethodPointBuy)
+	require.NoError(t, err)
+	require.NotNil(t, generation.PointBuy)
+	assert.Equal(t, 27, generation.PointBuy.Budget)
+	assert.Equal(t, 9, generation.PointBuy.Costs[15])
+
+	_, err = svc.Generate("dreamed")
+	assert.Error(t, err)
+}
+
+func TestAbilityScoreService_ValidatePointBuy(t *testing.T) {
+	svc := service.NewAbilityScoreService("test-secret")
+
+	result := svc.Validate(&models.AbilityScoreValidationRequest{
+		Method:        rules.MethodPointBuy,
+		AbilityScores: abilityScoresFrom([]int{15, 14, 13, 12, 10, 8}),
+	})
+	assert.True(t, result.Valid)
+	require.NotNil(t, result.PointsSpent)
+	assert.Equal(t, 27, *result.PointsSpent)
+
+	result = svc.Validate(&models.AbilityScoreValidationRequest{
+		Method:        rules.MethodPointBuy,
+		AbilityScores: abilityScoresFrom([]int{15, 15, 15, 15, 8, 8}),
+	})
+	assert.False(t, result.Valid)
+	assert.Contains(t, result.Errors, "point buy spends 36 points, more than the budget of 27")
+}
@@ -2,12 +2,18 @@ package service_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/config"
+	"github.com/yourusername/dnd-character-creator/internal/dice"
 	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/reference"
 	"github.com/yourusername/dnd-character-creator/internal/repository"
+	"github.com/yourusername/dnd-character-creator/internal/rules"
 	"github.com/yourusername/dnd-character-creator/internal/service"
 )
 
@@ -49,9 +55,20 @@ func (m *MockCharacterRepository) ExistsByName(ctx context.Context, name string,
 	return args.Bool(0), args.Error(1)
 }
 
+// WithTransaction runs fn directly; the mock has no writes to roll back
+func (m *MockCharacterRepository) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func newCharacterService(t *testing.T, repo repository.CharacterRepository) *service.CharacterService {
+	catalog, err := reference.Load()
+	require.NoError(t, err)
+	return service.NewCharacterService(repo, nil, catalog, config.RulesConfig{ValidationMode: "strict", RollSecret: "test-secret"})
+}
+
 func TestCharacterService_GetAll_Success(t *testing.T) {
 	mockRepo := new(MockCharacterRepository)
-	svc := service.NewCharacterService(mockRepo)
+	svc := newCharacterService(t, mockRepo)
 
 	expectedCharacters := []models.Character{
 		{ID: "507f1f77bcf86cd799439011", CharacterName: "Test1"},
@@ -70,7 +87,7 @@ func TestCharacterService_GetAll_Success(t *testing.T) {
 
 func TestCharacterService_GetByID_Success(t *testing.T) {
 	mockRepo := new(MockCharacterRepository)
-	svc := service.NewCharacterService(mockRepo)
+	svc := newCharacterService(t, mockRepo)
 
 	id := "507f1f77bcf86cd799439011"
 	expectedCharacter := &models.Character{
@@ -89,7 +106,7 @@ func TestCharacterService_GetByID_Success(t *testing.T) {
 
 func TestCharacterService_Create_Success(t *testing.T) {
 	mockRepo := new(MockCharacterRepository)
-	svc := service.NewCharacterService(mockRepo)
+	svc := newCharacterService(t, mockRepo)
 
 	character := &models.Character{
 		CharacterName: "New Character",
@@ -119,7 +136,7 @@ func TestCharacterService_Create_Success(t *testing.T) {
 
 func TestCharacterService_Create_DuplicateName(t *testing.T) {
 	mockRepo := new(MockCharacterRepository)
-	svc := service.NewCharacterService(mockRepo)
+	svc := newCharacterService(t, mockRepo)
 
 	character := &models.Character{
 		CharacterName: "Duplicate",
@@ -140,9 +157,29 @@ func TestCharacterService_Create_DuplicateName(t *testing.T) {
 	mockRepo.AssertNotCalled(t, "Create")
 }
 
+func TestCharacterService_Create_StrictModeRejectsUnknownClass(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	character := &models.Character{
+		CharacterName: "Typo",
+		Race:          "Human",
+		Class:         "Wizzard",
+		Level:         1,
+		AbilityScores: getValidAbilityScores(),
+	}
+
+	result, err := svc.Create(context.Background(), character)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), `class "Wizzard" is not in the reference catalog`)
+	mockRepo.AssertNotCalled(t, "Create")
+}
+
 func TestCharacterService_Update_Success(t *testing.T) {
 	mockRepo := new(MockCharacterRepository)
-	svc := service.NewCharacterService(mockRepo)
+	svc := newCharacterService(t, mockRepo)
 
 	id := "507f1f77bcf86cd799439011"
 	existing := &models.Character{
@@ -179,7 +216,7 @@ func TestCharacterService_Update_Success(t *testing.T) {
 
 func TestCharacterService_Delete_Success(t *testing.T) {
 	mockRepo := new(MockCharacterRepository)
-	svc := service.NewCharacterService(mockRepo)
+	svc := newCharacterService(t, mockRepo)
 
 	id := "507f1f77bcf86cd799439011"
 	mockRepo.On("Delete", mock.Anything, id).Return(nil)
@@ -195,10 +232,11 @@ func TestCharacterService_AbilityModifierCalculation(t *testing.T) {
 		score    int
 		modifier int
 	}{
-		{1, -4},  // (1-10)/2 = -4
-		{2, -4},  // (2-10)/2 = -4
-		{3, -3},  // (3-10)/2 = -3
-		{8, -1},  // (8-10)/2 = -1
+		{1, -5},  // floor((1-10)/2) = -5
+		{2, -4},  // floor((2-10)/2) = -4
+		{3, -4},  // floor((3-10)/2) = -4
+		{8, -1},  // floor((8-10)/2) = -1
+		{9, -1},  // floor((9-10)/2) = -1
 		{10, 0},  // (10-10)/2 = 0
 		{11, 0},  // (11-10)/2 = 0
 		{12, 1},  // (12-10)/2 = 1
@@ -209,7 +247,7 @@ func TestCharacterService_AbilityModifierCalculation(t *testing.T) {
 
 	for _, tt := range tests {
 		mockRepo := new(MockCharacterRepository)
-		svc := service.NewCharacterService(mockRepo)
+		svc := newCharacterService(t, mockRepo)
 
 		character := &models.Character{
 			CharacterName: "Test",
@@ -237,6 +275,175 @@ func TestCharacterService_AbilityModifierCalculation(t *testing.T) {
 	}
 }
 
+func TestCharacterService_Create_CalculatesDerivedStats(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	character := &models.Character{
+		CharacterName: "Derived",
+		Race:          "Human",
+		Class:         "Rogue",
+		Level:         5,
+		AbilityScores: models.AbilityScores{
+			Strength:     models.AbilityScore{Score: 8},
+			Dexterity:    models.AbilityScore{Score: 17},
+			Constitution: models.AbilityScore{Score: 12},
+			Intelligence: models.AbilityScore{Score: 13},
+			Wisdom:       models.AbilityScore{Score: 14},
+			Charisma:     models.AbilityScore{Score: 9},
+		},
+		SavingThrows: &models.SavingThrows{Dexterity: true, Intelligence: true},
+		Skills: models.Skills{
+			Stealth:    models.Skill{Proficient: true, Expertise: true},
+			Perception: models.Skill{Proficient: true},
+		},
+		// Client-supplied derived values are ignored
+		ProficiencyBonus:  6,
+		Initiative:        10,
+		PassivePerception: 30,
+	}
+
+	mockRepo.On("ExistsByName", mock.Anything, "Derived", "").Return(false, nil)
+	mockRepo.On("Create", mock.Anything, character).Return(nil)
+
+	result, err := svc.Create(context.Background(), character)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, result.ProficiencyBonus)
+	assert.Equal(t, 3, result.Initiative)
+	assert.Equal(t, 9, result.Skills.Stealth.Modifier)    // 3 + 2*3
+	assert.Equal(t, 5, result.Skills.Perception.Modifier) // 2 + 3
+	assert.Equal(t, -1, result.Skills.Athletics.Modifier)
+	assert.Equal(t, 15, result.PassivePerception)
+	assert.Equal(t, 6, result.SavingThrowBonuses.Dexterity)
+	assert.Equal(t, 4, result.SavingThrowBonuses.Intelligence)
+	assert.Equal(t, -1, result.SavingThrowBonuses.Charisma)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCharacterService_Create_ComputesScoresFromBaseScores(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	// Base Strength 12 and Charisma 12 only meet the Paladin prerequisites
+	// after the Half-Orc racial increase and a feat increase are applied
+	character := &models.Character{
+		CharacterName: "Layered",
+		Race:          "Half-Orc",
+		Class:         "Fighter",
+		Level:         2,
+		Multiclass:    []models.MulticlassEntry{{Class: "Paladin", Level: 1}},
+		AbilityScores: models.AbilityScores{
+			Strength:     models.AbilityScore{Base: 12},
+			Dexterity:    models.AbilityScore{Base: 10},
+			Constitution: models.AbilityScore{Base: 14},
+			Intelligence: models.AbilityScore{Base: 8},
+			Wisdom:       models.AbilityScore{Base: 10},
+			Charisma:     models.AbilityScore{Base: 12},
+			Method:       "manual",
+			Increases:    []models.AbilityScoreIncrease{{Ability: "charisma", Amount: 1, Source: "feat"}},
+		},
+	}
+
+	mockRepo.On("ExistsByName", mock.Anything, "Layered", "").Return(false, nil)
+	mockRepo.On("Create", mock.Anything, character).Return(nil)
+
+	result, err := svc.Create(context.Background(), character)
+
+	require.NoError(t, err)
+	assert.Equal(t, 14, result.AbilityScores.Strength.Score)
+	assert.Equal(t, 2, result.AbilityScores.Strength.Modifier)
+	assert.Equal(t, 15, result.AbilityScores.Constitution.Score)
+	assert.Equal(t, 13, result.AbilityScores.Charisma.Score)
+	assert.Equal(t, 12, result.AbilityScores.Strength.Base)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCharacterService_Create_RequiresIssuedRolledSeed(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	var rolled []int
+	for _, roll := range rules.RollAbilityScores(7) {
+		rolled = append(rolled, roll.Total)
+	}
+	rolledCharacter := func(seed string) *models.Character {
+		return &models.Character{
+			CharacterName: "Lucky",
+			Race:          "Human",
+			Class:         "Fighter",
+			Level:         1,
+			AbilityScores: models.AbilityScores{
+				Strength:     models.AbilityScore{Base: rolled[0]},
+				Dexterity:    models.AbilityScore{Base: rolled[1]},
+				Constitution: models.AbilityScore{Base: rolled[2]},
+				Intelligence: models.AbilityScore{Base: rolled[3]},
+				Wisdom:       models.AbilityScore{Base: rolled[4]},
+				Charisma:     models.AbilityScore{Base: rolled[5]},
+				Method:       rules.MethodRolled,
+				Seed:         seed,
+			},
+		}
+	}
+
+	// A seed the client picked itself matches its rolls but was never issued
+	_, err := svc.Create(context.Background(), rolledCharacter(dice.FormatSeed(7)))
+	assert.ErrorContains(t, err, "was not issued by this server")
+
+	_, err = svc.Create(context.Background(), rolledCharacter(dice.SignSeed([]byte("another-secret"), 7)))
+	assert.ErrorContains(t, err, "was not issued by this server")
+
+	character := rolledCharacter(dice.SignSeed([]byte("test-secret"), 7))
+	mockRepo.On("ExistsByName", mock.Anything, "Lucky", "").Return(false, nil)
+	mockRepo.On("Create", mock.Anything, character).Return(nil)
+
+	_, err = svc.Create(context.Background(), character)
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCharacterService_LevelUp(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	existing := &models.Character{
+		ID:            id,
+		CharacterName: "Veteran",
+		Race:          "Human",
+		Class:         "Fighter",
+		Level:         1,
+		AbilityScores: getValidAbilityScores(),
+		HitPoints:     models.HitPoints{Current: 10},
+	}
+
+	mockRepo.On("FindByID", mock.Anything, id).Return(existing, nil)
+	mockRepo.On("Update", mock.Anything, id, existing).Return(nil)
+
+	result, err := svc.LevelUp(context.Background(), id, &models.LevelUpRequest{Class: "Fighter"})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Character.Level)
+	assert.Equal(t, 16, result.Character.HitPoints.Maximum) // 10 + 6
+	assert.Equal(t, 16, result.Character.HitPoints.Current)
+	assert.Contains(t, result.Character.Features, models.Feature{Name: "Action Surge (one use)", Source: "Fighter 2"})
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCharacterService_LevelUp_NotFound(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	mockRepo.On("FindByID", mock.Anything, id).Return(nil, nil)
+
+	result, err := svc.LevelUp(context.Background(), id, &models.LevelUpRequest{Class: "Fighter"})
+
+	assert.EqualError(t, err, "character not found")
+	assert.Nil(t, result)
+	mockRepo.AssertNotCalled(t, "Update")
+}
+
 func getValidAbilityScores() models.AbilityScores {
 	return models.AbilityScores{
 		Strength:     models.AbilityScore{Score: 10, Modifier: 0},
@@ -247,3 +454,899 @@ func getValidAbilityScores() models.AbilityScores {
 		Charisma:     models.AbilityScore{Score: 10, Modifier: 0},
 	}
 }
+
+func TestCharacterService_AwardExperience(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	existing := &models.Character{
+		ID:               id,
+		CharacterName:    "Veteran",
+		Race:             "Human",
+		Class:            "Fighter",
+		Level:            1,
+		ExperiencePoints: 200,
+		AbilityScores:    getValidAbilityScores(),
+		HitPoints:        models.HitPoints{Current: 10},
+	}
+
+	mockRepo.On("FindByID", mock.Anything, id).Return(existing, nil)
+	mockRepo.On("Update", mock.Anything, id, existing).Return(nil)
+
+	result, err := svc.AwardExperience(context.Background(), id, &models.ExperienceAwardRequest{Amount: 150, Reason: "Wolf pack"})
+
+	require.NoError(t, err)
+	assert.True(t, result.ThresholdCrossed)
+	assert.True(t, result.Character.LevelUpAvailable)
+	assert.Equal(t, 350, result.Award.Total)
+	assert.False(t, result.Award.AwardedAt.IsZero())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCharacterService_Update_PreservesExperienceLedger(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	ledger := []models.ExperienceAward{{Amount: 300, Total: 300, Reason: "Wolf pack"}}
+	existing := &models.Character{
+		ID:               id,
+		CharacterName:    "Veteran",
+		ExperiencePoints: 300,
+		ExperienceLedger: ledger,
+	}
+	update := &models.Character{
+		CharacterName:    "Veteran",
+		Race:             "Human",
+		Class:            "Fighter",
+		Level:            1,
+		ExperiencePoints: 99999,
+		AbilityScores:    getValidAbilityScores(),
+		HitPoints:        models.HitPoints{Current: 10},
+	}
+
+	mockRepo.On("FindByID", mock.Anything, id).Return(existing, nil)
+	mockRepo.On("FindByName", mock.Anything, "Veteran").Return(existing, nil).Maybe()
+	mockRepo.On("Update", mock.Anything, id, mock.AnythingOfType("*models.Character")).Return(nil)
+
+	result, err := svc.Update(context.Background(), id, update)
+
+	require.NoError(t, err)
+	assert.Equal(t, 300, result.ExperiencePoints)
+	assert.Equal(t, ledger, result.ExperienceLedger)
+}
+
+func TestCharacterService_Update_KeepsExhaustionReduction(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	catalog, err := reference.Load()
+	require.NoError(t, err)
+	svc := service.NewCharacterService(mockRepo, nil, catalog, config.RulesConfig{ValidationMode: "lenient"})
+
+	id := "507f1f77bcf86cd799439011"
+	existing := &models.Character{
+		ID:            id,
+		CharacterName: "Drifter",
+		Exhaustion:    4,
+		HitPoints:     models.HitPoints{Maximum: 20, Current: 20, Reduction: 20},
+	}
+	update := &models.Character{
+		CharacterName: "Drifter",
+		Race:          "Human",
+		Class:         "Gunslinger",
+		Level:         5,
+		Exhaustion:    4,
+		AbilityScores: getValidAbilityScores(),
+		HitPoints:     models.HitPoints{Maximum: 20, Current: 20},
+	}
+
+	mockRepo.On("FindByID", mock.Anything, id).Return(existing, nil)
+	mockRepo.On("Update", mock.Anything, id, mock.AnythingOfType("*models.Character")).Return(nil)
+
+	result, err := svc.Update(context.Background(), id, update)
+
+	require.NoError(t, err)
+	assert.Equal(t, 20, result.HitPoints.Maximum)
+	assert.Equal(t, 20, result.HitPoints.Reduction)
+}
+
+func TestCharacterService_LongRest(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	existing := &models.Character{
+		ID:            id,
+		CharacterName: "Weary",
+		Race:          "Human",
+		Class:         "Fighter",
+		Level:         2,
+		AbilityScores: getValidAbilityScores(),
+		HitPoints:     models.HitPoints{Current: 3},
+		HitDice:       []models.HitDice{{Class: "Fighter", Spent: 2}},
+	}
+
+	mockRepo.On("FindByID", mock.Anything, id).Return(existing, nil)
+	mockRepo.On("Update", mock.Anything, id, existing).Return(nil)
+
+	result, err := svc.LongRest(context.Background(), id)
+
+	require.NoError(t, err)
+	assert.Equal(t, result.Character.HitPoints.Maximum, result.Character.HitPoints.Current)
+	assert.Equal(t, 1, result.HitDiceRecovered)
+	assert.Equal(t, 1, result.Character.HitDice[0].Spent)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCharacterService_ShortRest_NotFound(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	mockRepo.On("FindByID", mock.Anything, id).Return(nil, nil)
+
+	result, err := svc.ShortRest(context.Background(), id, &models.ShortRestRequest{})
+
+	assert.EqualError(t, err, "character not found")
+	assert.Nil(t, result)
+	mockRepo.AssertNotCalled(t, "Update")
+}
+
+func TestCharacterService_ChangeHitPoints_RetriesOnConflict(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	stored := func(current int) *models.Character {
+		return &models.Character{
+			ID:            id,
+			CharacterName: "Tank",
+			Race:          "Human",
+			Class:         "Fighter",
+			Level:         1,
+			AbilityScores: getValidAbilityScores(),
+			HitPoints:     models.HitPoints{Current: current},
+			Version:       3,
+		}
+	}
+
+	// The first read is stale; another client has already dealt damage
+	mockRepo.On("FindByID", mock.Anything, id).Return(stored(9), nil).Once()
+	mockRepo.On("FindByID", mock.Anything, id).Return(stored(7), nil).Once()
+	mockRepo.On("Update", mock.Anything, id, mock.MatchedBy(func(c *models.Character) bool { return c.HitPoints.Current == 4 })).Return(repository.ErrVersionConflict).Once()
+	mockRepo.On("Update", mock.Anything, id, mock.MatchedBy(func(c *models.Character) bool { return c.HitPoints.Current == 2 })).Return(nil).Once()
+
+	result, err := svc.ChangeHitPoints(context.Background(), id, &models.HitPointChangeRequest{Type: "damage", Amount: 5})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Character.HitPoints.Current)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCharacterService_Update_VersionConflict(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	existing := &models.Character{ID: id, CharacterName: "Tank", Version: 4}
+	update := &models.Character{
+		CharacterName: "Tank",
+		Race:          "Human",
+		Class:         "Fighter",
+		Level:         1,
+		AbilityScores: getValidAbilityScores(),
+		HitPoints:     models.HitPoints{Current: 10},
+		Version:       3,
+	}
+
+	mockRepo.On("FindByID", mock.Anything, id).Return(existing, nil)
+	mockRepo.On("ExistsByName", mock.Anything, "Tank", id).Return(false, nil).Maybe()
+	mockRepo.On("Update", mock.Anything, id, update).Return(repository.ErrVersionConflict)
+
+	result, err := svc.Update(context.Background(), id, update)
+
+	assert.ErrorIs(t, err, service.ErrVersionConflict)
+	assert.Nil(t, result)
+}
+
+func TestCharacterService_Update_DerivesEchoedClassLevel(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	existing := &models.Character{ID: id, CharacterName: "Tank", Level: 3, ClassLevel: 3}
+	update := &models.Character{
+		CharacterName: "Tank",
+		Race:          "Human",
+		Class:         "Fighter",
+		Level:         4,
+		ClassLevel:    3,
+		AbilityScores: getValidAbilityScores(),
+		HitPoints:     models.HitPoints{Current: 10},
+	}
+
+	mockRepo.On("FindByID", mock.Anything, id).Return(existing, nil)
+	mockRepo.On("Update", mock.Anything, id, update).Return(nil)
+
+	result, err := svc.Update(context.Background(), id, update)
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, result.ClassLevel)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCharacterService_Update_RejectsMismatchedClassLevels(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	multiclass := func(wizard int) []models.MulticlassEntry {
+		return []models.MulticlassEntry{{Class: "Wizard", Level: wizard}}
+	}
+	existing := &models.Character{ID: id, CharacterName: "Tank", Level: 5, ClassLevel: 3, Multiclass: multiclass(2)}
+	update := &models.Character{
+		CharacterName: "Tank",
+		Race:          "Human",
+		Class:         "Fighter",
+		Level:         5,
+		ClassLevel:    3,
+		Multiclass:    multiclass(3),
+		AbilityScores: getValidAbilityScores(),
+		HitPoints:     models.HitPoints{Current: 10},
+	}
+
+	mockRepo.On("FindByID", mock.Anything, id).Return(existing, nil)
+
+	result, err := svc.Update(context.Background(), id, update)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "level 5 must equal the sum of class levels (6)")
+	assert.Nil(t, result)
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCharacterService_AddCondition_Errors(t *testing.T) {
+	id := "507f1f77bcf86cd799439011"
+	existing := func() *models.Character {
+		return &models.Character{
+			ID:            id,
+			CharacterName: "Contested",
+			Race:          "Human",
+			Class:         "Fighter",
+			Level:         1,
+			AbilityScores: getValidAbilityScores(),
+			HitPoints:     models.HitPoints{Current: 10},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(repo *MockCharacterRepository)
+		target error
+	}{
+		{
+			name: "not found",
+			setup: func(repo *MockCharacterRepository) {
+				repo.On("FindByID", mock.Anything, id).Return(nil, nil)
+			},
+			target: service.ErrCharacterNotFound,
+		},
+		{
+			name: "keeps changing",
+			setup: func(repo *MockCharacterRepository) {
+				repo.On("FindByID", mock.Anything, id).Return(existing(), nil)
+				repo.On("Update", mock.Anything, id, mock.Anything).Return(repository.ErrVersionConflict)
+			},
+			target: service.ErrVersionConflict,
+		},
+		{
+			name: "database down",
+			setup: func(repo *MockCharacterRepository) {
+				repo.On("FindByID", mock.Anything, id).Return(nil, errors.New("connection refused"))
+			},
+			target: service.ErrStorage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockCharacterRepository)
+			svc := newCharacterService(t, mockRepo)
+			tt.setup(mockRepo)
+
+			_, err := svc.AddCondition(context.Background(), id, &models.ConditionRequest{Name: "poisoned"})
+
+			assert.ErrorIs(t, err, tt.target)
+		})
+	}
+}
+
+func TestCharacterService_DeathSave_NotDying(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	existing := &models.Character{
+		ID:            id,
+		CharacterName: "Healthy",
+		Race:          "Human",
+		Class:         "Fighter",
+		Level:         1,
+		AbilityScores: getValidAbilityScores(),
+		HitPoints:     models.HitPoints{Current: 10},
+	}
+
+	mockRepo.On("FindByID", mock.Anything, id).Return(existing, nil)
+
+	result, err := svc.DeathSave(context.Background(), id)
+
+	assert.EqualError(t, err, "character is not dying")
+	assert.Nil(t, result)
+	mockRepo.AssertNotCalled(t, "Update")
+}
+
+func TestCharacterService_Cast(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	existing := &models.Character{
+		ID:            id,
+		CharacterName: "Caster",
+		Race:          "Human",
+		Class:         "Cleric",
+		Level:         1,
+		AbilityScores: getValidAbilityScores(),
+		HitPoints:     models.HitPoints{Current: 8},
+		Spellcasting:  &models.Spellcasting{PreparedSpells: []string{"Bless"}},
+	}
+
+	mockRepo.On("FindByID", mock.Anything, id).Return(existing, nil)
+	mockRepo.On("Update", mock.Anything, id, existing).Return(nil)
+
+	result, err := svc.Cast(context.Background(), id, &models.CastRequest{Spell: "Bless"})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Character.Spellcasting.SpellSlots.Level1.Used)
+	assert.Equal(t, "Bless", result.Character.Spellcasting.Concentration.Spell)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCharacterService_AddCondition(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	existing := &models.Character{
+		ID:            id,
+		CharacterName: "Bitten",
+		Race:          "Human",
+		Class:         "Fighter",
+		Level:         1,
+		AbilityScores: getValidAbilityScores(),
+		HitPoints:     models.HitPoints{Current: 10},
+	}
+
+	mockRepo.On("FindByID", mock.Anything, id).Return(existing, nil)
+	mockRepo.On("Update", mock.Anything, id, existing).Return(nil)
+
+	result, err := svc.AddCondition(context.Background(), id, &models.ConditionRequest{Name: "poisoned"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "disadvantage", result.ConditionEffects.AttackRolls)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCharacterService_AddCondition_KeepsFreeFormCharacterPlayable(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	// Saved before strict validation, with a class the catalog doesn't know
+	id := "507f1f77bcf86cd799439011"
+	existing := &models.Character{
+		ID:            id,
+		CharacterName: "Homebrewed",
+		Race:          "Human",
+		Class:         "Gunslinger",
+		Level:         1,
+		AbilityScores: getValidAbilityScores(),
+		HitPoints:     models.HitPoints{Current: 10},
+	}
+
+	mockRepo.On("FindByID", mock.Anything, id).Return(existing, nil)
+	mockRepo.On("Update", mock.Anything, id, existing).Return(nil)
+
+	_, err := svc.AddCondition(context.Background(), id, &models.ConditionRequest{Name: "poisoned"})
+
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+
+	// Editing the character itself still applies strict validation
+	edited := *existing
+	_, err = svc.Update(context.Background(), id, &edited)
+	assert.ErrorContains(t, err, "Gunslinger")
+}
+
+func TestCharacterService_RemoveCondition_NotPresent(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	existing := &models.Character{
+		ID:            id,
+		CharacterName: "Healthy",
+		Race:          "Human",
+		Class:         "Fighter",
+		Level:         1,
+		AbilityScores: getValidAbilityScores(),
+		HitPoints:     models.HitPoints{Current: 10},
+	}
+
+	mockRepo.On("FindByID", mock.Anything, id).Return(existing, nil)
+
+	result, err := svc.RemoveCondition(context.Background(), id, "poisoned")
+
+	assert.EqualError(t, err, "character is not poisoned")
+	assert.Nil(t, result)
+	mockRepo.AssertNotCalled(t, "Update")
+}
+
+func TestCharacterService_RollAttack(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	existing := &models.Character{
+		ID:            id,
+		CharacterName: "Striker",
+		Race:          "Human",
+		Class:         "Fighter",
+		Level:         1,
+		AbilityScores: getValidAbilityScores(),
+		HitPoints:     models.HitPoints{Current: 10},
+		Attacks:       []models.Attack{{Name: "Dagger", AttackBonus: 4, Damage: "1d4+2", DamageType: "piercing"}},
+	}
+
+	mockRepo.On("FindByID", mock.Anything, id).Return(existing, nil)
+
+	result, err := svc.RollAttack(context.Background(), id, 0, &models.AttackRollRequest{TargetAC: 1})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Dagger", result.Attack)
+	assert.Equal(t, result.Natural+4, result.Total)
+	assert.Equal(t, result.Natural != 1, result.Hit)
+	mockRepo.AssertNotCalled(t, "Update")
+}
+
+func TestCharacterService_RollAttack_NotFound(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	mockRepo.On("FindByID", mock.Anything, id).Return(nil, nil)
+
+	result, err := svc.RollAttack(context.Background(), id, 0, &models.AttackRollRequest{TargetAC: 10})
+
+	assert.EqualError(t, err, "character not found")
+	assert.Nil(t, result)
+}
+
+func TestCharacterService_SpendResource(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	existing := &models.Character{
+		ID:            id,
+		CharacterName: "Raging",
+		Race:          "Human",
+		Class:         "Barbarian",
+		Level:         1,
+		AbilityScores: getValidAbilityScores(),
+		HitPoints:     models.HitPoints{Current: 12},
+	}
+
+	mockRepo.On("FindByID", mock.Anything, id).Return(existing, nil)
+	mockRepo.On("Update", mock.Anything, id, existing).Return(nil)
+
+	result, err := svc.SpendResource(context.Background(), id, "Rage", &models.ResourceUseRequest{})
+
+	require.NoError(t, err)
+	require.Len(t, result.Resources, 1)
+	assert.Equal(t, 1, result.Resources[0].Current)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCharacterService_SpendResource_NoneLeft(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	existing := &models.Character{
+		ID:            id,
+		CharacterName: "Tired",
+		Race:          "Human",
+		Class:         "Barbarian",
+		Level:         1,
+		AbilityScores: getValidAbilityScores(),
+		HitPoints:     models.HitPoints{Current: 12},
+		Resources:     []models.Resource{{Name: "Rage", Used: 2}},
+	}
+
+	mockRepo.On("FindByID", mock.Anything, id).Return(existing, nil)
+
+	result, err := svc.SpendResource(context.Background(), id, "Rage", &models.ResourceUseRequest{Amount: 1})
+
+	assert.EqualError(t, err, "only 0 uses of Rage remain")
+	assert.Nil(t, result)
+	mockRepo.AssertNotCalled(t, "Update")
+}
+
+func TestCharacterService_Transact(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	existing := &models.Character{
+		ID:            id,
+		CharacterName: "Shopper",
+		Race:          "Human",
+		Class:         "Fighter",
+		Level:         1,
+		AbilityScores: getValidAbilityScores(),
+		HitPoints:     models.HitPoints{Current: 10},
+		Inventory:     &models.Inventory{Currency: &models.Currency{Platinum: 1}},
+	}
+
+	mockRepo.On("FindByID", mock.Anything, id).Return(existing, nil)
+	mockRepo.On("Update", mock.Anything, id, existing).Return(nil)
+
+	result, err := svc.Transact(context.Background(), id, &models.CurrencyTransactionRequest{
+		Type:         "debit",
+		Amount:       3,
+		Denomination: "gp",
+		Memo:         "Healer's kit",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, models.Currency{Gold: 7}, *result.Character.Inventory.Currency)
+	assert.Equal(t, "Healer's kit", result.Transaction.Memo)
+	assert.False(t, result.Transaction.TransactedAt.IsZero())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCharacterService_Update_PreservesCurrencyLedger(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	ledger := []models.CurrencyTransaction{{Type: "credit", Amount: 12, Denomination: "gp", Balance: models.Currency{Gold: 12}}}
+	existing := &models.Character{
+		ID:             id,
+		CharacterName:  "Saver",
+		CurrencyLedger: ledger,
+		Inventory:      &models.Inventory{Currency: &models.Currency{Gold: 12}},
+	}
+	update := &models.Character{
+		CharacterName: "Saver",
+		Race:          "Human",
+		Class:         "Fighter",
+		Level:         1,
+		AbilityScores: getValidAbilityScores(),
+		HitPoints:     models.HitPoints{Current: 10},
+		Inventory:     &models.Inventory{Currency: &models.Currency{Platinum: 500}},
+	}
+
+	mockRepo.On("FindByID", mock.Anything, id).Return(existing, nil)
+	mockRepo.On("Update", mock.Anything, id, mock.AnythingOfType("*models.Character")).Return(nil)
+
+	result, err := svc.Update(context.Background(), id, update)
+
+	require.NoError(t, err)
+	assert.Equal(t, models.Currency{Gold: 12}, *result.Inventory.Currency)
+	assert.Equal(t, ledger, result.CurrencyLedger)
+}
+
+func TestCharacterService_GetByID_ResolvesHomebrewItems(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	mockItems := new(MockItemRepository)
+	catalog, err := reference.Load()
+	require.NoError(t, err)
+	svc := service.NewCharacterService(mockRepo, mockItems, catalog, config.RulesConfig{ValidationMode: "strict"})
+
+	id := "507f1f77bcf86cd799439011"
+	homebrewID := "607f1f77bcf86cd799439011"
+	existing := &models.Character{
+		ID:            id,
+		CharacterName: "Collector",
+		Race:          "Human",
+		Class:         "Fighter",
+		Level:         1,
+		AbilityScores: getValidAbilityScores(),
+		Inventory: &models.Inventory{Items: []models.InventoryItem{
+			{ItemID: "longsword", Quantity: 1},
+			{ItemID: homebrewID, Quantity: 1},
+		}},
+	}
+
+	mockRepo.On("FindByID", mock.Anything, id).Return(existing, nil)
+	mockItems.On("FindByIDs", mock.Anything, []string{homebrewID}).
+		Return([]models.Item{{ID: homebrewID, Name: "Sunblade", Source: "homebrew", Category: "weapon", Weight: 3}}, nil)
+
+	result, err := svc.GetByID(context.Background(), id)
+
+	require.NoError(t, err)
+	items := result.Inventory.Items
+	require.NotNil(t, items[0].Item)
+	assert.Equal(t, "Longsword", items[0].Item.Name)
+	require.NotNil(t, items[1].Item)
+	assert.Equal(t, "Sunblade", items[1].Item.Name)
+	require.NotNil(t, result.Encumbrance)
+	assert.Equal(t, 6.0, result.Encumbrance.CarriedWeight)
+	mockItems.AssertExpectations(t)
+}
+
+func TestCharacterService_Create_RejectsUnknownItems(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	mockItems := new(MockItemRepository)
+	catalog, err := reference.Load()
+	require.NoError(t, err)
+	svc := service.NewCharacterService(mockRepo, mockItems, catalog, config.RulesConfig{ValidationMode: "strict"})
+
+	character := &models.Character{
+		CharacterName: "Hoarder",
+		Race:          "Human",
+		Class:         "Fighter",
+		Level:         1,
+		AbilityScores: getValidAbilityScores(),
+		Inventory:     &models.Inventory{Items: []models.InventoryItem{{ItemID: "vorpal-spoon", Quantity: 1}}},
+	}
+
+	mockItems.On("FindByIDs", mock.Anything, []string{"vorpal-spoon"}).Return([]models.Item{}, nil)
+
+	result, err := svc.Create(context.Background(), character)
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), `inventory.items[0] "vorpal-spoon" is not an SRD or homebrew item`)
+	mockRepo.AssertNotCalled(t, "Create")
+}
+
+func TestCharacterService_Equip(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	existing := &models.Character{
+		ID:            id,
+		CharacterName: "Squire",
+		Race:          "Human",
+		Class:         "Fighter",
+		Level:         1,
+		AbilityScores: getValidAbilityScores(),
+		HitPoints:     models.HitPoints{Current: 10},
+		Inventory: &models.Inventory{Items: []models.InventoryItem{
+			{ID: "sword", ItemID: "longsword", Quantity: 1},
+		}},
+	}
+
+	mockRepo.On("FindByID", mock.Anything, id).Return(existing, nil)
+	mockRepo.On("Update", mock.Anything, id, existing).Return(nil)
+
+	result, err := svc.Equip(context.Background(), id, "sword", &models.EquipRequest{})
+
+	require.NoError(t, err)
+	assert.True(t, result.Inventory.Items[0].Equipped)
+	require.Len(t, result.Attacks, 1)
+	assert.Equal(t, "Longsword", result.Attacks[0].Name)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCharacterService_Unequip_NotEquipped(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	existing := &models.Character{
+		ID:            id,
+		CharacterName: "Squire",
+		Race:          "Human",
+		Class:         "Fighter",
+		Level:         1,
+		AbilityScores: getValidAbilityScores(),
+		HitPoints:     models.HitPoints{Current: 10},
+		Inventory: &models.Inventory{Items: []models.InventoryItem{
+			{ID: "sword", ItemID: "longsword", Quantity: 1},
+		}},
+	}
+
+	mockRepo.On("FindByID", mock.Anything, id).Return(existing, nil)
+
+	result, err := svc.Unequip(context.Background(), id, "sword", &models.UnequipRequest{})
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "Longsword is not equipped", err.Error())
+	mockRepo.AssertNotCalled(t, "Update")
+}
+
+func TestCharacterService_Dawn(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	spent := 0
+	existing := &models.Character{
+		ID:            id,
+		CharacterName: "Evoker",
+		Race:          "Human",
+		Class:         "Fighter",
+		Level:         1,
+		AbilityScores: getValidAbilityScores(),
+		HitPoints:     models.HitPoints{Current: 10},
+		Inventory: &models.Inventory{Items: []models.InventoryItem{{
+			ID:       "wand",
+			ItemID:   "607f1f77bcf86cd799439011",
+			Quantity: 1,
+			Charges:  &spent,
+			Item: &models.Item{ID: "607f1f77bcf86cd799439011", Name: "Wand of Magic Missiles", Source: "homebrew", Category: "gear", Magic: &models.MagicItem{
+				Rarity:  "uncommon",
+				Charges: &models.ItemCharges{Maximum: 7, Recharge: "dawn", Regain: "1d6+1"},
+			}},
+		}}},
+	}
+
+	mockRepo.On("FindByID", mock.Anything, id).Return(existing, nil)
+	mockRepo.On("Update", mock.Anything, id, existing).Return(nil)
+
+	result, err := svc.Dawn(context.Background(), id)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Wand of Magic Missiles"}, result.ItemsRecharged)
+	charges := *result.Character.Inventory.Items[0].Charges
+	assert.GreaterOrEqual(t, charges, 2)
+	assert.LessOrEqual(t, charges, 7)
+	mockRepo.AssertExpectations(t)
+}
+
+// transferCharacter is a stored character taking part in a transfer
+func transferCharacter(id string, name string, items ...models.InventoryItem) *models.Character {
+	return &models.Character{
+		ID:            id,
+		CharacterName: name,
+		Race:          "Human",
+		Class:         "Fighter",
+		Level:         1,
+		AbilityScores: getValidAbilityScores(),
+		HitPoints:     models.HitPoints{Current: 10},
+		Inventory:     &models.Inventory{Items: items, Currency: &models.Currency{Gold: 10}},
+	}
+}
+
+func TestCharacterService_Transfer(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	fromID := "507f1f77bcf86cd799439011"
+	toID := "507f1f77bcf86cd799439012"
+	from := transferCharacter(fromID, "Giver", models.InventoryItem{ID: "torch", ItemID: "torch", Quantity: 1})
+	to := transferCharacter(toID, "Receiver")
+
+	mockRepo.On("FindByID", mock.Anything, fromID).Return(from, nil)
+	mockRepo.On("FindByID", mock.Anything, toID).Return(to, nil)
+	mockRepo.On("Update", mock.Anything, fromID, from).Return(nil)
+	mockRepo.On("Update", mock.Anything, toID, to).Return(nil)
+
+	result, err := svc.Transfer(context.Background(), &models.TransferRequest{
+		FromCharacterID: fromID,
+		ToCharacterID:   toID,
+		Items:           []models.TransferItemRequest{{ID: "torch"}},
+		Currency:        &models.TransferCurrency{Amount: 4, Denomination: "gp"},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, result.From.Inventory.Items)
+	require.Len(t, result.To.Inventory.Items, 1)
+	assert.Equal(t, 6, result.From.Inventory.Currency.Gold)
+	assert.Equal(t, 14, result.To.Inventory.Currency.Gold)
+	assert.Len(t, result.From.Transfers, 1)
+	assert.Len(t, result.To.Transfers, 1)
+	assert.False(t, result.Transfer.TransferredAt.IsZero())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCharacterService_Transfer_RejectedTransferSavesNothing(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	fromID := "507f1f77bcf86cd799439011"
+	toID := "507f1f77bcf86cd799439012"
+	mockRepo.On("FindByID", mock.Anything, fromID).Return(transferCharacter(fromID, "Giver"), nil)
+	mockRepo.On("FindByID", mock.Anything, toID).Return(transferCharacter(toID, "Receiver"), nil)
+
+	result, err := svc.Transfer(context.Background(), &models.TransferRequest{
+		FromCharacterID: fromID,
+		ToCharacterID:   toID,
+		Currency:        &models.TransferCurrency{Amount: 11, Denomination: "gp"},
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "insufficient funds")
+	mockRepo.AssertNotCalled(t, "Update")
+}
+
+func TestCharacterService_Transfer_RetriesVersionConflict(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	fromID := "507f1f77bcf86cd799439011"
+	toID := "507f1f77bcf86cd799439012"
+	mockRepo.On("FindByID", mock.Anything, fromID).Return(transferCharacter(fromID, "Giver"), nil).Once()
+	mockRepo.On("FindByID", mock.Anything, fromID).Return(transferCharacter(fromID, "Giver"), nil).Once()
+	mockRepo.On("FindByID", mock.Anything, toID).Return(transferCharacter(toID, "Receiver"), nil).Once()
+	mockRepo.On("FindByID", mock.Anything, toID).Return(transferCharacter(toID, "Receiver"), nil).Once()
+	mockRepo.On("Update", mock.Anything, fromID, mock.Anything).Return(nil).Twice()
+	mockRepo.On("Update", mock.Anything, toID, mock.Anything).Return(repository.ErrVersionConflict).Once()
+	mockRepo.On("Update", mock.Anything, toID, mock.Anything).Return(nil).Once()
+
+	result, err := svc.Transfer(context.Background(), &models.TransferRequest{
+		FromCharacterID: fromID,
+		ToCharacterID:   toID,
+		Currency:        &models.TransferCurrency{Amount: 4, Denomination: "gp"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 6, result.From.Inventory.Currency.Gold, "the retry starts from the stored purse")
+	assert.Equal(t, 14, result.To.Inventory.Currency.Gold)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCharacterService_Transfer_NotFound(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	fromID := "507f1f77bcf86cd799439011"
+	toID := "507f1f77bcf86cd799439012"
+	mockRepo.On("FindByID", mock.Anything, fromID).Return(transferCharacter(fromID, "Giver"), nil)
+	mockRepo.On("FindByID", mock.Anything, toID).Return(nil, nil)
+
+	_, err := svc.Transfer(context.Background(), &models.TransferRequest{
+		FromCharacterID: fromID,
+		ToCharacterID:   toID,
+		Currency:        &models.TransferCurrency{Amount: 1, Denomination: "gp"},
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, "character not found", err.Error())
+}
+
+func TestCharacterService_MoveItem(t *testing.T) {
+	mockRepo := new(MockCharacterRepository)
+	svc := newCharacterService(t, mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	existing := &models.Character{
+		ID:            id,
+		CharacterName: "Packer",
+		Race:          "Human",
+		Class:         "Fighter",
+		Level:         1,
+		AbilityScores: getValidAbilityScores(),
+		HitPoints:     models.HitPoints{Current: 10},
+		Inventory: &models.Inventory{Items: []models.InventoryItem{
+			{ID: "pack", ItemID: "backpack", Quantity: 1},
+			{ID: "torches", ItemID: "torch", Quantity: 3},
+		}},
+	}
+
+	mockRepo.On("FindByID", mock.Anything, id).Return(existing, nil)
+	mockRepo.On("Update", mock.Anything, id, existing).Return(nil)
+
+	result, err := svc.MoveItem(context.Background(), id, "torches", &models.MoveItemRequest{ContainerID: "pack"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "pack", result.Inventory.Items[1].ContainerID)
+	assert.Equal(t, 3.0, result.Inventory.Items[0].ContentsWeight)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,33 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/service"
+)
+
+func TestDiceService_Roll_RepeatsWithSeed(t *testing.T) {
+	svc := service.NewDiceService()
+
+	first, err := svc.Roll(&models.RollRequest{Notation: "8d6"})
+	require.NoError(t, err)
+	require.NotEmpty(t, first.Seed)
+	assert.Len(t, first.Terms[0].Dice, 8)
+
+	second, err := svc.Roll(&models.RollRequest{Notation: "8d6", Seed: first.Seed})
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+}
+
+func TestDiceService_Roll_Invalid(t *testing.T) {
+	svc := service.NewDiceService()
+
+	_, err := svc.Roll(&models.RollRequest{Notation: "1d8+"})
+	assert.Error(t, err)
+
+	_, err = svc.Roll(&models.RollRequest{Notation: "1d8", Seed: "not-hex"})
+	assert.EqualError(t, err, `seed "not-hex" is not a valid hexadecimal seed`)
+}
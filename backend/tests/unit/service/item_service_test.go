@@ -0,0 +1,160 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/repository"
+	"github.com/yourusername/dnd-character-creator/internal/service"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// MockItemRepository mocks the homebrew item repository
+type MockItemRepository struct {
+	mock.Mock
+}
+
+func (m *MockItemRepository) FindAll(ctx context.Context, filter repository.ItemFilter) ([]models.Item, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]models.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) FindByID(ctx context.Context, id string) (*models.Item, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) FindByIDs(ctx context.Context, ids []string) ([]models.Item, error) {
+	args := m.Called(ctx, ids)
+	return args.Get(0).([]models.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) Create(ctx context.Context, item *models.Item) error {
+	args := m.Called(ctx, item)
+	return args.Error(0)
+}
+
+func (m *MockItemRepository) Update(ctx context.Context, id string, item *models.Item) error {
+	args := m.Called(ctx, id, item)
+	return args.Error(0)
+}
+
+func (m *MockItemRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func TestItemService_Create_MarksItemHomebrew(t *testing.T) {
+	mockRepo := new(MockItemRepository)
+	svc := service.NewItemService(mockRepo)
+
+	item := &models.Item{Name: "Sunblade", Category: "weapon", Damage: "1d8", DamageType: "radiant", Source: "srd"}
+	mockRepo.On("Create", mock.Anything, item).Return(nil)
+
+	result, err := svc.Create(context.Background(), item)
+
+	require.NoError(t, err)
+	assert.Equal(t, "homebrew", result.Source)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestItemService_Create_ValidationErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		item     models.Item
+		expected string
+	}{
+		{"armor without a category", models.Item{Name: "Hide", Category: "armor"}, "armorCategory is required for armor"},
+		{"armor category on gear", models.Item{Name: "Rope", Category: "gear", ArmorCategory: "light"}, "armorCategory is only allowed for armor"},
+		{"bad damage", models.Item{Name: "Spoon", Category: "weapon", Damage: "1d"}, "damage:"},
+		{"unknown damage type", models.Item{Name: "Spoon", Category: "weapon", Damage: "1d4", DamageType: "sharp"}, `damageType "sharp" is not a damage type`},
+		{"unknown modifier target", models.Item{Name: "Cloak", Category: "gear", Magic: &models.MagicItem{Rarity: "rare", Modifiers: []models.ItemModifier{{Target: "luck", Value: 1}}}}, `magic.modifiers[0] "luck" is not a stat an item can modify`},
+		{"setting armor class", models.Item{Name: "Cloak", Category: "gear", Magic: &models.MagicItem{Rarity: "rare", Modifiers: []models.ItemModifier{{Target: "armorClass", Operation: "set", Value: 18}}}}, "magic.modifiers[0] can only set ability scores"},
+		{"regain without dawn", models.Item{Name: "Wand", Category: "gear", Magic: &models.MagicItem{Rarity: "rare", Charges: &models.ItemCharges{Maximum: 7, Recharge: "longRest", Regain: "1d6"}}}, "magic.charges.regain is only allowed for charges that recharge at dawn"},
+		{"weightless mundane container", models.Item{Name: "Sack", Category: "gear", Container: &models.ItemContainer{Capacity: 30, Weightless: true}}, "container.weightless is only allowed for magic items"},
+		{"prerequisites without attunement", models.Item{Name: "Wand", Category: "gear", Magic: &models.MagicItem{Rarity: "rare", Prerequisites: &models.AttunementPrerequisites{Spellcaster: true}}}, "magic.prerequisites are only allowed for items that require attunement"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockItemRepository)
+			svc := service.NewItemService(mockRepo)
+
+			result, err := svc.Create(context.Background(), &tt.item)
+
+			require.Error(t, err)
+			assert.Nil(t, result)
+			assert.Contains(t, err.Error(), tt.expected)
+			mockRepo.AssertNotCalled(t, "Create")
+		})
+	}
+}
+
+func TestItemService_Update_NotFound(t *testing.T) {
+	mockRepo := new(MockItemRepository)
+	svc := service.NewItemService(mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	item := &models.Item{Name: "Sunblade", Category: "weapon"}
+	mockRepo.On("Update", mock.Anything, id, item).Return(mongo.ErrNoDocuments)
+
+	result, err := svc.Update(context.Background(), id, item)
+
+	assert.ErrorIs(t, err, service.ErrItemNotFound)
+	assert.Nil(t, result)
+}
+
+func TestItemService_Delete_NotFound(t *testing.T) {
+	mockRepo := new(MockItemRepository)
+	svc := service.NewItemService(mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	mockRepo.On("Delete", mock.Anything, id).Return(mongo.ErrNoDocuments)
+
+	err := svc.Delete(context.Background(), id)
+
+	assert.ErrorIs(t, err, service.ErrItemNotFound)
+}
+
+func TestItemService_StorageErrors(t *testing.T) {
+	mockRepo := new(MockItemRepository)
+	svc := service.NewItemService(mockRepo)
+
+	id := "507f1f77bcf86cd799439011"
+	failure := errors.New("connection reset")
+	mockRepo.On("FindByID", mock.Anything, id).Return(nil, failure)
+	mockRepo.On("Delete", mock.Anything, id).Return(failure)
+
+	_, err := svc.GetByID(context.Background(), id)
+	assert.ErrorIs(t, err, service.ErrStorage)
+	assert.ErrorIs(t, err, failure)
+
+	err = svc.Delete(context.Background(), id)
+	assert.ErrorIs(t, err, service.ErrStorage)
+	assert.NotErrorIs(t, err, service.ErrItemNotFound)
+}
+
+func TestItemService_Create_MagicItem(t *testing.T) {
+	mockRepo := new(MockItemRepository)
+	svc := service.NewItemService(mockRepo)
+
+	item := &models.Item{Name: "Gauntlets of Ogre Power", Category: "gear", Magic: &models.MagicItem{
+		Rarity:             "uncommon",
+		RequiresAttunement: true,
+		Modifiers:          []models.ItemModifier{{Target: "str", Operation: "set", Value: 19}},
+	}}
+	mockRepo.On("Create", mock.Anything, item).Return(nil)
+
+	_, err := svc.Create(context.Background(), item)
+
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
@@ -119,6 +119,135 @@ func TestCharacterValidator_Validate_LevelConstraints(t *testing.T) {
 	}
 }
 
+func TestCharacterValidator_Validate_ClassLevelsMatchTotalLevel(t *testing.T) {
+	v := validator.NewCharacterValidator()
+
+	tests := []struct {
+		name          string
+		level         int
+		classLevel    int
+		multiclass    []models.MulticlassEntry
+		expectedError string
+	}{
+		{"single class", 5, 0, nil, ""},
+		{"derived primary level", 5, 0, []models.MulticlassEntry{{Class: "Rogue", Level: 2}}, ""},
+		{"explicit matching levels", 5, 3, []models.MulticlassEntry{{Class: "Rogue", Level: 2}}, ""},
+		{"explicit mismatched levels", 5, 4, []models.MulticlassEntry{{Class: "Rogue", Level: 2}}, "level 5 must equal the sum of class levels (6)"},
+		{"multiclass exceeds total", 3, 0, []models.MulticlassEntry{{Class: "Rogue", Level: 20}}, "multiclass levels (20) leave no levels in class for total level 3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := &models.Character{
+				CharacterName: "Test",
+				Race:          "Human",
+				Class:         "Fighter",
+				Level:         tt.level,
+				ClassLevel:    tt.classLevel,
+				Multiclass:    tt.multiclass,
+				AbilityScores: getValidAbilityScores(),
+			}
+
+			errors := v.Validate(character)
+			if tt.expectedError == "" {
+				assert.Empty(t, errors)
+			} else {
+				assert.Contains(t, errors, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestCharacterValidator_Validate_EquippedArmorLimits(t *testing.T) {
+	v := validator.NewCharacterValidator()
+
+	character := &models.Character{
+		CharacterName: "Test",
+		Race:          "Human",
+		Class:         "Fighter",
+		Level:         5,
+		AbilityScores: getValidAbilityScores(),
+		Inventory: &models.Inventory{
+			Armor: []models.ArmorItem{
+				{Name: "Chain Mail", Type: "Heavy Armor", ArmorClass: 16, Equipped: true},
+				{Name: "Leather Armor", Type: "Light Armor", ArmorClass: 11, Equipped: true},
+				{Name: "Shield", Type: "Shield", ArmorClass: 2, Equipped: true},
+			},
+		},
+	}
+
+	errors := v.Validate(character)
+	assert.Contains(t, errors, "only one body armor can be equipped at a time")
+	assert.NotContains(t, errors, "only one shield can be equipped at a time")
+
+	character.Inventory.Armor[1].Equipped = false
+	assert.Empty(t, v.Validate(character))
+}
+
+func TestCharacterValidator_Validate_EquippedWeaponLimits(t *testing.T) {
+	v := validator.NewCharacterValidator()
+
+	character := &models.Character{
+		CharacterName: "Test",
+		Race:          "Human",
+		Class:         "Fighter",
+		Level:         5,
+		AbilityScores: getValidAbilityScores(),
+		Inventory: &models.Inventory{
+			Weapons: []models.Weapon{
+				{Name: "Longsword", Quantity: 1, Equipped: true},
+				{Name: "Dagger", Quantity: 1, Equipped: true},
+			},
+			Armor: []models.ArmorItem{
+				{Name: "Shield", Type: "Shield", ArmorClass: 2, Equipped: true},
+			},
+		},
+	}
+
+	errors := v.Validate(character)
+	assert.Contains(t, errors, "equipped weapons and shields need 3 hands but a character only has 2")
+
+	character.Inventory.Weapons[1].Equipped = false
+	assert.Empty(t, v.Validate(character))
+}
+
+func TestCharacterValidator_Validate_DamageTypes(t *testing.T) {
+	v := validator.NewCharacterValidator()
+
+	character := &models.Character{
+		CharacterName:         "Test",
+		Race:                  "Human",
+		Class:                 "Fighter",
+		Level:                 5,
+		AbilityScores:         getValidAbilityScores(),
+		DamageResistances:     []string{"Fire", "cold"},
+		DamageImmunities:      []string{"poison"},
+		DamageVulnerabilities: []string{"sonic"},
+	}
+
+	errors := v.Validate(character)
+	assert.Equal(t, []string{`damageVulnerabilities[0] "sonic" is not a damage type`}, errors)
+}
+
+func TestCharacterValidator_Validate_Conditions(t *testing.T) {
+	v := validator.NewCharacterValidator()
+
+	character := &models.Character{
+		CharacterName: "Test",
+		Race:          "Human",
+		Class:         "Fighter",
+		Level:         5,
+		AbilityScores: getValidAbilityScores(),
+		Conditions:    []models.Condition{{Name: "Poisoned"}, {Name: "exhaustion"}, {Name: "sleepy"}},
+	}
+
+	errors := v.Validate(character)
+	assert.Equal(t, []string{
+		`conditions[1] "exhaustion" is not a condition`,
+		`conditions[2] "sleepy" is not a condition`,
+	}, errors)
+}
+
 func TestCharacterValidator_Validate_AbilityScoreConstraints(t *testing.T) {
 	v := validator.NewCharacterValidator()
 
@@ -189,3 +318,51 @@ func getValidAbilityScores() models.AbilityScores {
 		Charisma:     models.AbilityScore{Score: 10, Modifier: 0},
 	}
 }
+
+func TestValidateAbilityScores_BaseScores(t *testing.T) {
+	v := validator.NewCharacterValidator()
+
+	character := &models.Character{
+		CharacterName: "Test",
+		Race:          "Human",
+		Class:         "Fighter",
+		Level:         1,
+		AbilityScores: models.AbilityScores{
+			Strength:     models.AbilityScore{Base: 15},
+			Dexterity:    models.AbilityScore{Base: 15},
+			Constitution: models.AbilityScore{Base: 15},
+			Intelligence: models.AbilityScore{Base: 15},
+			Wisdom:       models.AbilityScore{Base: 8},
+			Charisma:     models.AbilityScore{Base: 8},
+			Method:       "pointBuy",
+			Increases:    []models.AbilityScoreIncrease{{Ability: "luck", Amount: 1, Source: "feat"}},
+		},
+	}
+
+	errors := v.Validate(character)
+
+	assert.NotContains(t, errors, "strength must be between 1 and 30")
+	assert.Contains(t, errors, "abilityScores.base: point buy spends 36 points, more than the budget of 27")
+	assert.Contains(t, errors, `abilityScores.increases[0].ability "luck" is not an ability`)
+}
+
+func TestValidateAbilityScores_BaseScoresRequireMethod(t *testing.T) {
+	v := validator.NewCharacterValidator()
+
+	character := &models.Character{
+		CharacterName: "Test",
+		Race:          "Human",
+		Class:         "Fighter",
+		Level:         1,
+		AbilityScores: models.AbilityScores{
+			Strength:     models.AbilityScore{Base: 18},
+			Dexterity:    models.AbilityScore{Base: 18},
+			Constitution: models.AbilityScore{Base: 18},
+			Intelligence: models.AbilityScore{Base: 18},
+			Wisdom:       models.AbilityScore{Base: 18},
+			Charisma:     models.AbilityScore{Base: 18},
+		},
+	}
+
+	assert.Contains(t, v.Validate(character), "abilityScores.method is required with base scores")
+}
@@ -0,0 +1,326 @@
+package validator_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/dnd-character-creator/internal/models"
+	"github.com/yourusername/dnd-character-creator/internal/reference"
+	"github.com/yourusername/dnd-character-creator/internal/validator"
+)
+
+func newRulesValidator(t *testing.T, mode validator.Mode) *validator.CharacterValidator {
+	catalog, err := reference.Load()
+	require.NoError(t, err)
+	return validator.NewRulesValidator(catalog, mode)
+}
+
+func TestRulesValidator_ValidCharacter(t *testing.T) {
+	v := newRulesValidator(t, validator.ModeStrict)
+
+	character := &models.Character{
+		CharacterName: "Elowen",
+		Race:          "Elf",
+		Subrace:       "High Elf",
+		Class:         "Wizard",
+		Subclass:      "School of Evocation",
+		Level:         3,
+		AbilityScores: getValidAbilityScores(),
+		Spellcasting: &models.Spellcasting{
+			CantripsKnown: []string{"Fire Bolt"},
+			SpellsKnown:   []string{"Magic Missile", "Shield"},
+		},
+	}
+
+	assert.Empty(t, v.Validate(character))
+}
+
+func TestRulesValidator_CatalogViolations(t *testing.T) {
+	v := newRulesValidator(t, validator.ModeStrict)
+
+	tests := []struct {
+		name          string
+		modify        func(c *models.Character)
+		expectedError string
+	}{
+		{
+			name:          "misspelled class",
+			modify:        func(c *models.Character) { c.Class = "Wizzard" },
+			expectedError: `class "Wizzard" is not in the reference catalog`,
+		},
+		{
+			name:          "subrace from another race",
+			modify:        func(c *models.Character) { c.Subrace = "Hill Dwarf" },
+			expectedError: `subrace "Hill Dwarf" does not belong to race "Elf"`,
+		},
+		{
+			name:          "subclass from another class",
+			modify:        func(c *models.Character) { c.Subclass = "Champion" },
+			expectedError: `subclass "Champion" does not belong to class "Wizard"`,
+		},
+		{
+			name: "subclass not yet unlocked",
+			modify: func(c *models.Character) {
+				c.Level = 1
+				c.Subclass = "School of Evocation"
+			},
+			expectedError: `subclass "School of Evocation" is not available until Wizard level 2`,
+		},
+		{
+			name: "multiclass prerequisites not met",
+			modify: func(c *models.Character) {
+				c.Level = 4
+				c.Multiclass = []models.MulticlassEntry{{Class: "Paladin", Level: 1}}
+			},
+			expectedError: `multiclass[0].class "Paladin" requires strength 13 and charisma 13 to multiclass`,
+		},
+		{
+			name: "hit point roll larger than the hit die",
+			modify: func(c *models.Character) {
+				c.HitPoints.History = []models.HitPointLevel{
+					{Class: "Wizard", Method: "max"},
+					{Class: "Wizard", Roll: 8, Method: "rolled"},
+				}
+			},
+			expectedError: `hitPoints.history[1] roll 8 is not possible on a d6`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := &models.Character{
+				CharacterName: "Test",
+				Race:          "Elf",
+				Class:         "Wizard",
+				Level:         3,
+				AbilityScores: models.AbilityScores{
+					Strength:     models.AbilityScore{Score: 10},
+					Dexterity:    models.AbilityScore{Score: 14},
+					Constitution: models.AbilityScore{Score: 12},
+					Intelligence: models.AbilityScore{Score: 16},
+					Wisdom:       models.AbilityScore{Score: 10},
+					Charisma:     models.AbilityScore{Score: 8},
+				},
+			}
+			tt.modify(character)
+
+			assert.Contains(t, v.Validate(character), tt.expectedError)
+		})
+	}
+}
+
+func TestRulesValidator_SpellsOffClassListsOnlyWarn(t *testing.T) {
+	v := newRulesValidator(t, validator.ModeStrict)
+
+	// Magical Secrets lets a bard learn spells from any class
+	character := &models.Character{
+		CharacterName: "Lyra",
+		Race:          "Human",
+		Class:         "Bard",
+		Level:         10,
+		AbilityScores: getValidAbilityScores(),
+		Spellcasting: &models.Spellcasting{
+			CantripsKnown: []string{"Vicious Mockery"},
+			SpellsKnown:   []string{"Healing Word", "Fireball"},
+		},
+	}
+
+	assert.Empty(t, v.Validate(character))
+	assert.Equal(t, []string{`spellcasting.spellsKnown[1] "Fireball" is not on the spell list for Bard`}, v.Warnings(character))
+}
+
+func TestRulesValidator_RestrictMethods(t *testing.T) {
+	v := newRulesValidator(t, validator.ModeStrict).RestrictMethods([]string{"pointBuy", "standardArray"})
+
+	character := &models.Character{
+		CharacterName: "Test",
+		Race:          "Human",
+		Class:         "Fighter",
+		Level:         1,
+		AbilityScores: getValidAbilityScores(),
+	}
+	assert.Contains(t, v.Validate(character), `abilityScores.method "manual" is not allowed, use one of pointBuy, standardArray`)
+
+	character.AbilityScores = models.AbilityScores{
+		Method:       "manual",
+		Strength:     models.AbilityScore{Base: 18},
+		Dexterity:    models.AbilityScore{Base: 18},
+		Constitution: models.AbilityScore{Base: 18},
+		Intelligence: models.AbilityScore{Base: 18},
+		Wisdom:       models.AbilityScore{Base: 18},
+		Charisma:     models.AbilityScore{Base: 18},
+	}
+	assert.Contains(t, v.Validate(character), `abilityScores.method "manual" is not allowed, use one of pointBuy, standardArray`)
+
+	character.AbilityScores = models.AbilityScores{
+		Method:       "standardArray",
+		Strength:     models.AbilityScore{Base: 15},
+		Dexterity:    models.AbilityScore{Base: 14},
+		Constitution: models.AbilityScore{Base: 13},
+		Intelligence: models.AbilityScore{Base: 12},
+		Wisdom:       models.AbilityScore{Base: 10},
+		Charisma:     models.AbilityScore{Base: 8},
+	}
+	assert.Empty(t, v.Validate(character))
+}
+
+func TestRulesValidator_FighterMulticlassNeedsStrengthOrDexterity(t *testing.T) {
+	v := newRulesValidator(t, validator.ModeStrict)
+
+	character := &models.Character{
+		CharacterName: "Test",
+		Race:          "Human",
+		Class:         "Rogue",
+		Level:         3,
+		Multiclass:    []models.MulticlassEntry{{Class: "Fighter", Level: 1}},
+		AbilityScores: models.AbilityScores{
+			Strength:     models.AbilityScore{Score: 8},
+			Dexterity:    models.AbilityScore{Score: 15},
+			Constitution: models.AbilityScore{Score: 10},
+			Intelligence: models.AbilityScore{Score: 10},
+			Wisdom:       models.AbilityScore{Score: 10},
+			Charisma:     models.AbilityScore{Score: 10},
+		},
+	}
+
+	assert.Empty(t, v.Validate(character))
+}
+
+func TestRulesValidator_AbilityScoreIncreases(t *testing.T) {
+	v := newRulesValidator(t, validator.ModeStrict)
+
+	layered := func(race string, version string, increases ...models.AbilityScoreIncrease) *models.Character {
+		return &models.Character{
+			CharacterName: "Test",
+			Race:          race,
+			Class:         "Wizard",
+			Background:    "Acolyte",
+			Level:         4,
+			AbilityScores: models.AbilityScores{
+				Strength:     models.AbilityScore{Base: 8},
+				Dexterity:    models.AbilityScore{Base: 14},
+				Constitution: models.AbilityScore{Base: 13},
+				Intelligence: models.AbilityScore{Base: 15},
+				Wisdom:       models.AbilityScore{Base: 12},
+				Charisma:     models.AbilityScore{Base: 10},
+				RulesVersion: version,
+				Method:       "pointBuy",
+				Increases:    increases,
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		character     *models.Character
+		expectedError string
+	}{
+		{
+			name: "half-elf choices",
+			character: layered("Half-Elf", "2014",
+				models.AbilityScoreIncrease{Ability: "intelligence", Amount: 1, Source: "race"},
+				models.AbilityScoreIncrease{Ability: "dexterity", Amount: 1, Source: "race"},
+			),
+		},
+		{
+			name: "half-elf cannot choose charisma",
+			character: layered("Half-Elf", "2014",
+				models.AbilityScoreIncrease{Ability: "charisma", Amount: 1, Source: "race"},
+			),
+			expectedError: `abilityScores.increases[0] charisma cannot be chosen for race "Half-Elf"`,
+		},
+		{
+			name: "race without choices",
+			character: layered("Elf", "2014",
+				models.AbilityScoreIncrease{Ability: "intelligence", Amount: 1, Source: "race"},
+			),
+			expectedError: `race "Elf" has no ability score increases to choose`,
+		},
+		{
+			name: "background increases under 2014 rules",
+			character: layered("Elf", "2014",
+				models.AbilityScoreIncrease{Ability: "intelligence", Amount: 2, Source: "background"},
+			),
+			expectedError: "background ability score increases require the 2024 rules",
+		},
+		{
+			name: "2024 background increases",
+			character: layered("Elf", "2024",
+				models.AbilityScoreIncrease{Ability: "intelligence", Amount: 2, Source: "background"},
+				models.AbilityScoreIncrease{Ability: "wisdom", Amount: 1, Source: "background"},
+			),
+		},
+		{
+			name: "2024 background ability not listed",
+			character: layered("Elf", "2024",
+				models.AbilityScoreIncrease{Ability: "strength", Amount: 2, Source: "background"},
+				models.AbilityScoreIncrease{Ability: "wisdom", Amount: 1, Source: "background"},
+			),
+			expectedError: `abilityScores.increases[0] strength is not an ability of background "Acolyte"`,
+		},
+		{
+			name: "2024 background increases must total 3",
+			character: layered("Elf", "2024",
+				models.AbilityScoreIncrease{Ability: "intelligence", Amount: 2, Source: "background"},
+				models.AbilityScoreIncrease{Ability: "wisdom", Amount: 2, Source: "background"},
+			),
+			expectedError: "background ability score increases must total 3, got 4",
+		},
+		{
+			name: "too many level-up increases",
+			character: layered("Elf", "2014",
+				models.AbilityScoreIncrease{Ability: "intelligence", Amount: 2, Source: "levelUp", Level: 4},
+				models.AbilityScoreIncrease{Ability: "dexterity", Amount: 1, Source: "levelUp", Level: 4},
+			),
+			expectedError: "level-up ability score increases total 3 but only 1 Ability Score Improvements are available",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errors := v.Validate(tt.character)
+			if tt.expectedError == "" {
+				assert.Empty(t, errors)
+			} else {
+				assert.Contains(t, errors, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestRulesValidator_LenientModeAcceptsHomebrew(t *testing.T) {
+	v := newRulesValidator(t, validator.ModeLenient)
+
+	character := &models.Character{
+		CharacterName: "Test",
+		Race:          "Kobold",
+		Class:         "Blood Hunter",
+		Level:         5,
+		AbilityScores: getValidAbilityScores(),
+	}
+
+	assert.Empty(t, v.Validate(character))
+	assert.NotEmpty(t, v.ValidateRules(character))
+}
+
+func TestParseMode(t *testing.T) {
+	assert.Equal(t, validator.ModeLenient, validator.ParseMode("lenient"))
+	assert.Equal(t, validator.ModeStrict, validator.ParseMode("strict"))
+	assert.Equal(t, validator.ModeStrict, validator.ParseMode(""))
+}
+
+func TestRulesValidator_NaturalArmorOnlyInLenientMode(t *testing.T) {
+	character := &models.Character{
+		CharacterName:     "Scales",
+		Race:              "Human",
+		Class:             "Druid",
+		Level:             1,
+		AbilityScores:     getValidAbilityScores(),
+		ArmorClassEffects: &models.ArmorClassEffects{NaturalArmor: 13},
+	}
+
+	assert.Contains(t, newRulesValidator(t, validator.ModeStrict).Validate(character),
+		"armorClassEffects.naturalArmor is derived from the reference catalog and cannot be set")
+	assert.Empty(t, newRulesValidator(t, validator.ModeLenient).Validate(character))
+}